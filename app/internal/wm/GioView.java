@@ -49,6 +49,8 @@ public final class GioView extends SurfaceView implements Choreographer.FrameCal
 	private final float scrollYScale;
 
 	private long nhandle;
+	// inputType is the EditorInfo input type for the on-screen keyboard.
+	private int inputType;
 
 	public GioView(Context context) {
 		this(context, null);
@@ -248,9 +250,18 @@ public final class GioView extends SurfaceView implements Choreographer.FrameCal
 	}
 
 	@Override public InputConnection onCreateInputConnection(EditorInfo outAttrs) {
+		outAttrs.inputType = inputType;
 		return new InputConnection(this);
 	}
 
+	void setInputHint(int hint) {
+		if (hint == inputType) {
+			return;
+		}
+		inputType = hint;
+		imm.restartInput(this);
+	}
+
 	void showTextInput() {
 		GioView.this.requestFocus();
 		imm.showSoftInput(GioView.this, 0);
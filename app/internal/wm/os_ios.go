@@ -19,6 +19,7 @@ struct drawParams {
 
 __attribute__ ((visibility ("hidden"))) void gio_showTextInput(CFTypeRef viewRef);
 __attribute__ ((visibility ("hidden"))) void gio_hideTextInput(CFTypeRef viewRef);
+__attribute__ ((visibility ("hidden"))) void gio_setInputHint(CFTypeRef viewRef, UIKeyboardType keyboard, BOOL secure);
 __attribute__ ((visibility ("hidden"))) void gio_addLayerToView(CFTypeRef viewRef, CFTypeRef layerRef);
 __attribute__ ((visibility ("hidden"))) void gio_updateView(CFTypeRef viewRef, CFTypeRef layerRef);
 __attribute__ ((visibility ("hidden"))) void gio_removeLayer(CFTypeRef layerRef);
@@ -297,6 +298,24 @@ func (w *window) ShowTextInput(show bool) {
 	}
 }
 
+func (w *window) SetInputHint(mode key.InputHint) {
+	keyboard := C.UIKeyboardType(C.UIKeyboardTypeDefault)
+	secure := C.BOOL(C.NO)
+	switch mode {
+	case key.HintNumeric:
+		keyboard = C.UIKeyboardTypeDecimalPad
+	case key.HintEmail:
+		keyboard = C.UIKeyboardTypeEmailAddress
+	case key.HintURL:
+		keyboard = C.UIKeyboardTypeURL
+	case key.HintTelephone:
+		keyboard = C.UIKeyboardTypePhonePad
+	case key.HintPassword:
+		secure = C.YES
+	}
+	C.gio_setInputHint(w.view, keyboard, secure)
+}
+
 // Close the window. Not implemented for iOS.
 func (w *window) Close() {}
 
@@ -9,6 +9,8 @@
 #include "framework_ios.h"
 
 @interface GioView: UIView <UIKeyInput>
+@property(nonatomic) UIKeyboardType keyboardType;
+@property(nonatomic, getter=isSecureTextEntry) BOOL secureTextEntry;
 @end
 
 @implementation GioViewController
@@ -257,6 +259,15 @@ void gio_hideTextInput(CFTypeRef viewRef) {
 	[view resignFirstResponder];
 }
 
+void gio_setInputHint(CFTypeRef viewRef, UIKeyboardType keyboard, BOOL secure) {
+	GioView *view = (__bridge GioView *)viewRef;
+	view.keyboardType = keyboard;
+	view.secureTextEntry = secure;
+	if (view.isFirstResponder) {
+		[view reloadInputViews];
+	}
+}
+
 void gio_addLayerToView(CFTypeRef viewRef, CFTypeRef layerRef) {
 	UIView *view = (__bridge UIView *)viewRef;
 	CALayer *layer = (__bridge CALayer *)layerRef;
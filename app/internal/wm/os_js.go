@@ -524,6 +524,24 @@ func (w *window) ShowTextInput(show bool) {
 	}()
 }
 
+func (w *window) SetInputHint(mode key.InputHint) {
+	// Values from https://html.spec.whatwg.org/multipage/interaction.html#input-modalities:-the-inputmode-attribute.
+	// HintPassword is not supported, because the type of a textarea
+	// can't be changed.
+	m := "text"
+	switch mode {
+	case key.HintNumeric:
+		m = "decimal"
+	case key.HintEmail:
+		m = "email"
+	case key.HintURL:
+		m = "url"
+	case key.HintTelephone:
+		m = "tel"
+	}
+	w.tarea.Set("inputMode", m)
+}
+
 // Close the window. Not implemented for js.
 func (w *window) Close() {}
 
@@ -10,6 +10,7 @@ import (
 
 	"gioui.org/gpu"
 	"gioui.org/io/event"
+	"gioui.org/io/key"
 	"gioui.org/io/pointer"
 	"gioui.org/io/system"
 	"gioui.org/unit"
@@ -86,6 +87,9 @@ type Driver interface {
 	SetAnimating(anim bool)
 	// ShowTextInput updates the virtual keyboard state.
 	ShowTextInput(show bool)
+	// SetInputHint selects the virtual keyboard for the type of
+	// text expected.
+	SetInputHint(mode key.InputHint)
 	NewContext() (Context, error)
 
 	// ReadClipboard requests the clipboard content.
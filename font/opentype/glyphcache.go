@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package opentype
+
+import (
+	"sync"
+
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// glyphCache is a bounded cache of glyph outlines, safe for concurrent
+// use. Text shaped from cached outlines skips decoding the glyphs from
+// the font data.
+type glyphCache struct {
+	mu         sync.Mutex
+	m          map[glyphKey]*glyphElem
+	head, tail *glyphElem
+	hits       uint64
+	misses     uint64
+}
+
+type glyphElem struct {
+	next, prev *glyphElem
+	key        glyphKey
+	segs       []sfnt.Segment
+}
+
+type glyphKey struct {
+	ppem  fixed.Int26_6
+	index sfnt.GlyphIndex
+}
+
+// GlyphStats describes the use of a glyph cache, for tuning.
+type GlyphStats struct {
+	// Hits and Misses count the glyph lookups served from the cache and
+	// decoded from the font data, respectively.
+	Hits, Misses uint64
+	// Size is the number of cached glyphs.
+	Size int
+}
+
+// maxGlyphs bounds the number of glyphs in a glyphCache.
+const maxGlyphs = 4096
+
+func (c *glyphCache) Get(k glyphKey) ([]sfnt.Segment, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if g, ok := c.m[k]; ok {
+		c.hits++
+		c.remove(g)
+		c.insert(g)
+		return g.segs, true
+	}
+	c.misses++
+	return nil, false
+}
+
+func (c *glyphCache) Put(k glyphKey, segs []sfnt.Segment) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.m == nil {
+		c.m = make(map[glyphKey]*glyphElem)
+		c.head = new(glyphElem)
+		c.tail = new(glyphElem)
+		c.head.prev = c.tail
+		c.tail.next = c.head
+	}
+	if old, ok := c.m[k]; ok {
+		c.remove(old)
+	}
+	g := &glyphElem{key: k, segs: segs}
+	c.m[k] = g
+	c.insert(g)
+	if len(c.m) > maxGlyphs {
+		oldest := c.tail.next
+		c.remove(oldest)
+		delete(c.m, oldest.key)
+	}
+}
+
+func (c *glyphCache) Stats() GlyphStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return GlyphStats{Hits: c.hits, Misses: c.misses, Size: len(c.m)}
+}
+
+func (c *glyphCache) remove(g *glyphElem) {
+	g.next.prev = g.prev
+	g.prev.next = g.next
+}
+
+func (c *glyphCache) insert(g *glyphElem) {
+	g.next = c.head
+	g.prev = c.head.prev
+	g.prev.next = g
+	g.next.prev = g
+}
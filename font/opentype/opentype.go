@@ -23,7 +23,7 @@ import (
 // Font implements text.Face. Its methods are safe to use
 // concurrently.
 type Font struct {
-	font *sfnt.Font
+	font *opentype
 }
 
 // Collection is a collection of one or more fonts. When used as a text.Face,
@@ -36,6 +36,9 @@ type Collection struct {
 type opentype struct {
 	Font    *sfnt.Font
 	Hinting font.Hinting
+	// glyphs caches the outlines of the font, shared by every Face
+	// that includes it.
+	glyphs glyphCache
 }
 
 // a glyph represents a rune and its advance according to a Font.
@@ -52,7 +55,7 @@ func Parse(src []byte) (*Font, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Font{font: fnt}, nil
+	return &Font{font: &opentype{Font: fnt, Hinting: font.HintingFull}}, nil
 }
 
 // ParseCollection parses an SFNT font collection, such as TTC or OTC data,
@@ -106,7 +109,7 @@ func (c *Collection) Font(i int) (*Font, error) {
 	if i < 0 || len(c.fonts) <= i {
 		return nil, sfnt.ErrNotFound
 	}
-	return &Font{font: c.fonts[i].Font}, nil
+	return &Font{font: c.fonts[i]}, nil
 }
 
 func (f *Font) Layout(ppem fixed.Int26_6, maxWidth int, txt io.Reader) ([]text.Line, error) {
@@ -114,20 +117,45 @@ func (f *Font) Layout(ppem fixed.Int26_6, maxWidth int, txt io.Reader) ([]text.L
 	if err != nil {
 		return nil, err
 	}
-	fonts := []*opentype{{Font: f.font, Hinting: font.HintingFull}}
 	var buf sfnt.Buffer
-	return layoutText(&buf, ppem, maxWidth, fonts, glyphs)
+	return layoutText(&buf, ppem, maxWidth, []*opentype{f.font}, glyphs)
 }
 
 func (f *Font) Shape(ppem fixed.Int26_6, str text.Layout) op.CallOp {
 	var buf sfnt.Buffer
-	return textPath(&buf, ppem, []*opentype{{Font: f.font, Hinting: font.HintingFull}}, str)
+	return textPath(&buf, ppem, []*opentype{f.font}, str)
 }
 
 func (f *Font) Metrics(ppem fixed.Int26_6) font.Metrics {
-	o := &opentype{Font: f.font, Hinting: font.HintingFull}
 	var buf sfnt.Buffer
-	return o.Metrics(&buf, ppem)
+	return f.font.Metrics(&buf, ppem)
+}
+
+// WithFallback returns a collection of f followed by the fallback
+// faces, for assigning glyphs missing from f. Fallback faces other
+// than *Font and *Collection are ignored.
+func (f *Font) WithFallback(fallbacks []text.Face) text.Face {
+	return withFallback([]*opentype{f.font}, fallbacks)
+}
+
+// WithFallback returns a collection of the fonts of c followed by the
+// fallback faces, for assigning glyphs missing from c. Fallback faces
+// other than *Font and *Collection are ignored.
+func (c *Collection) WithFallback(fallbacks []text.Face) text.Face {
+	return withFallback(c.fonts, fallbacks)
+}
+
+func withFallback(fonts []*opentype, fallbacks []text.Face) *Collection {
+	fonts = fonts[:len(fonts):len(fonts)]
+	for _, fb := range fallbacks {
+		switch fb := fb.(type) {
+		case *Font:
+			fonts = append(fonts, fb.font)
+		case *Collection:
+			fonts = append(fonts, fb.fonts...)
+		}
+	}
+	return &Collection{fonts: fonts}
 }
 
 func (c *Collection) Layout(ppem fixed.Int26_6, maxWidth int, txt io.Reader) ([]text.Line, error) {
@@ -139,6 +167,33 @@ func (c *Collection) Layout(ppem fixed.Int26_6, maxWidth int, txt io.Reader) ([]
 	return layoutText(&buf, ppem, maxWidth, c.fonts, glyphs)
 }
 
+// Metrics returns the metrics of the first font of the collection.
+func (c *Collection) Metrics(ppem fixed.Int26_6) font.Metrics {
+	if len(c.fonts) == 0 {
+		return font.Metrics{}
+	}
+	var buf sfnt.Buffer
+	return c.fonts[0].Metrics(&buf, ppem)
+}
+
+// GlyphStats returns the glyph cache statistics of the font.
+func (f *Font) GlyphStats() GlyphStats {
+	return f.font.glyphs.Stats()
+}
+
+// GlyphStats returns the glyph cache statistics summed over the fonts
+// of the collection.
+func (c *Collection) GlyphStats() GlyphStats {
+	var s GlyphStats
+	for _, f := range c.fonts {
+		fs := f.glyphs.Stats()
+		s.Hits += fs.Hits
+		s.Misses += fs.Misses
+		s.Size += fs.Size
+	}
+	return s
+}
+
 func (c *Collection) Shape(ppem fixed.Int26_6, str text.Layout) op.CallOp {
 	var buf sfnt.Buffer
 	return textPath(&buf, ppem, c.fonts, str)
@@ -404,9 +459,16 @@ func (f *opentype) LoadGlyph(buf *sfnt.Buffer, ppem fixed.Int26_6, r rune) ([]sf
 	if err != nil {
 		return nil, false
 	}
+	k := glyphKey{ppem: ppem, index: g}
+	if segs, ok := f.glyphs.Get(k); ok {
+		return segs, true
+	}
 	segs, err := f.Font.LoadGlyph(buf, g, ppem, nil)
 	if err != nil {
 		return nil, false
 	}
+	// The segments are backed by buf; copy them for the cache.
+	segs = append([]sfnt.Segment(nil), segs...)
+	f.glyphs.Put(k, segs)
 	return segs, true
 }
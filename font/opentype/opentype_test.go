@@ -91,6 +91,69 @@ func TestCollectionAsFace(t *testing.T) {
 	}
 }
 
+func TestFallback(t *testing.T) {
+	font1, _, err := decompressFontFile("testdata/only1.ttf.gz")
+	if err != nil {
+		t.Fatalf("failed to load test font 1: %v", err)
+	}
+	font2, _, err := decompressFontFile("testdata/only2.ttf.gz")
+	if err != nil {
+		t.Fatalf("failed to load test font 2: %v", err)
+	}
+	face := font1.WithFallback([]text.Face{font2})
+	for _, tc := range []struct {
+		r    rune
+		want text.Face
+	}{
+		{'1', font1},
+		{'2', font2},
+		{'3', font1},
+	} {
+		got, err := shapeRune(face, tc.r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := shapeRune(tc.want, tc.r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !areShapesEqual(got, want) {
+			t.Errorf("glyph %q not drawn from the expected font", tc.r)
+		}
+	}
+
+	// Fallbacks registered with a cache apply to its faces.
+	cache := text.NewCache([]text.FontFace{{Face: font1}})
+	cache.SetFallbacks(font2)
+	ppem := fixed.I(200)
+	lines := cache.LayoutString(text.Font{}, ppem, 2000, "2")
+	got := cache.Shape(text.Font{}, ppem, lines[0].Layout)
+	want, err := shapeRune(font2, '2')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !areShapesEqual(got, want) {
+		t.Error("cache did not use the fallback font")
+	}
+}
+
+func TestCacheMetrics(t *testing.T) {
+	face, err := Parse(goregular.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := text.NewCache([]text.FontFace{{Face: face}})
+	ppem := fixed.I(20)
+	m := cache.Metrics(text.Font{}, ppem)
+	if m.Ascent <= 0 || m.Descent <= 0 {
+		t.Fatalf("got metrics %+v, expected positive ascent and descent", m)
+	}
+	lines := cache.LayoutString(text.Font{}, ppem, 1000, "x")
+	if got, want := m.LineHeight(), lines[0].Ascent+lines[0].Descent; got != want {
+		t.Errorf("got line height %v, want %v", got, want)
+	}
+}
+
 func TestEmptyString(t *testing.T) {
 	face, err := Parse(goregular.TTF)
 	if err != nil {
@@ -107,7 +170,7 @@ func TestEmptyString(t *testing.T) {
 		t.Fatalf("Layout returned no lines for empty string; expected 1")
 	}
 	l := lines[0]
-	exp, err := face.font.Bounds(new(sfnt.Buffer), ppem, font.HintingFull)
+	exp, err := face.font.Font.Bounds(new(sfnt.Buffer), ppem, font.HintingFull)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -116,6 +179,47 @@ func TestEmptyString(t *testing.T) {
 	}
 }
 
+func TestGlyphCache(t *testing.T) {
+	face, err := Parse(goregular.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ppem := fixed.I(20)
+	shape := func(str string) op.CallOp {
+		lines, err := face.Layout(ppem, 1000, strings.NewReader(str))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return face.Shape(ppem, lines[0].Layout)
+	}
+	first := shape("abba")
+	if got, want := face.GlyphStats(), (GlyphStats{Hits: 2, Misses: 2, Size: 2}); got != want {
+		t.Errorf("got stats %+v, want %+v", got, want)
+	}
+	// Shaping reuses the cached outlines of other text.
+	second := shape("ab")
+	if got := face.GlyphStats(); got.Hits != 4 || got.Misses != 2 {
+		t.Errorf("got stats %+v, want 4 hits and 2 misses", got)
+	}
+	if !areShapesEqual(shapeOf(t, face, ppem, "ab"), second) || areShapesEqual(first, second) {
+		t.Error("cached outlines changed the shape")
+	}
+	coll := face.WithFallback(nil).(*Collection)
+	if got, want := coll.GlyphStats(), face.GlyphStats(); got != want {
+		t.Errorf("collection stats %+v differ from the stats of its font %+v", got, want)
+	}
+}
+
+// shapeOf shapes str with an empty glyph cache.
+func shapeOf(t *testing.T, f *Font, ppem fixed.Int26_6, str string) op.CallOp {
+	fresh := &Font{font: &opentype{Font: f.font.Font, Hinting: f.font.Hinting}}
+	lines, err := fresh.Layout(ppem, 1000, strings.NewReader(str))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fresh.Shape(ppem, lines[0].Layout)
+}
+
 func decompressFontFile(name string) (*Font, []byte, error) {
 	f, err := os.Open(name)
 	if err != nil {
@@ -4,8 +4,8 @@
 Package gesture implements common pointer gestures.
 
 Gestures accept low level pointer Events from an event
-Queue and detect higher level actions such as clicks
-and scrolling.
+Queue and detect higher level actions such as clicks,
+scrolling, pinch zooming and rotation.
 */
 package gesture
 
@@ -30,6 +30,10 @@ const doubleClickDuration = 200 * time.Millisecond
 // Click detects click gestures in the form
 // of ClickEvents.
 type Click struct {
+	// Buttons is the set of mouse buttons that start clicks. Zero
+	// means ButtonPrimary. Touch pointers always click.
+	Buttons pointer.Buttons
+
 	// clickedAt is the timestamp at which
 	// the last click occurred.
 	clickedAt time.Duration
@@ -42,10 +46,22 @@ type Click struct {
 	entered bool
 	// pid is the pointer.ID.
 	pid pointer.ID
+	// buttons is the button of the current press.
+	buttons pointer.Buttons
 }
 
 type ClickState uint8
 
+// Hover detects mouse pointers hovering over an area. It ignores
+// touch pointers, and pointers pressed elsewhere.
+type Hover struct {
+	hovered bool
+	pid     pointer.ID
+	pos     f32.Point
+	entered bool
+	exited  bool
+}
+
 // ClickEvent represent a click action, either a
 // TypePress for the beginning of a click or a
 // TypeClick for a completed click.
@@ -54,6 +70,9 @@ type ClickEvent struct {
 	Position  f32.Point
 	Source    pointer.Source
 	Modifiers key.Modifiers
+	// Buttons is the button of the click, ButtonPrimary for touch
+	// pointers.
+	Buttons pointer.Buttons
 	// NumClicks records successive clicks occurring
 	// within a short duration of each other.
 	NumClicks int
@@ -73,6 +92,17 @@ type Drag struct {
 // scroll distances. Scroll recognizes mouse wheel
 // movements as well as drag and fling touch gestures.
 type Scroll struct {
+	// Decay is the rate of exponential decay of the fling
+	// velocity, per second. Larger values stop flings sooner.
+	// Zero means the platform default.
+	Decay float32
+	// LineSize is the smallest mouse wheel distance treated as a
+	// discrete wheel step. Steps are animated over a short duration
+	// instead of scrolling at once, while smaller, pixel precise
+	// distances such as from trackpads are applied directly. Zero
+	// means a default size.
+	LineSize unit.Value
+
 	dragging  bool
 	axis      Axis
 	estimator fling.Extrapolation
@@ -82,6 +112,37 @@ type Scroll struct {
 	last      int
 	// Leftover scroll.
 	scroll float32
+	// wheel is the remaining distance of animated wheel steps.
+	wheel     float32
+	wheelTime time.Time
+}
+
+// Transform detects pan, pinch zoom and rotation gestures from one
+// or more pointers.
+type Transform struct {
+	pointers []transformPointer
+	grab     bool
+	// moved is the distance moved by a single pointer before
+	// grabbing.
+	moved f32.Point
+}
+
+type transformPointer struct {
+	id  pointer.ID
+	pos f32.Point
+}
+
+// TransformDelta is the change of a transform gesture: a scale and
+// rotation around Origin followed by a translation by Pan.
+type TransformDelta struct {
+	// Origin is the center of the scale and rotation.
+	Origin f32.Point
+	// Scale is the scale factor.
+	Scale float32
+	// Rotation is the counter clockwise rotation in radians.
+	Rotation float32
+	// Pan is the translation.
+	Pan f32.Point
 }
 
 type ScrollState uint8
@@ -118,6 +179,13 @@ const (
 
 var touchSlop = unit.Dp(3)
 
+// wheelLine is the default Scroll.LineSize.
+var wheelLine = unit.Dp(24)
+
+// wheelDuration is the time constant of the exponential wheel step
+// animation.
+const wheelDuration = 60 * time.Millisecond
+
 // Add the handler to the operation list to receive click events.
 func (c *Click) Add(ops *op.Ops) {
 	op := pointer.InputOp{
@@ -158,7 +226,7 @@ func (c *Click) Events(q event.Queue) []ClickEvent {
 					c.clicks = 1
 				}
 				c.clickedAt = e.Time
-				events = append(events, ClickEvent{Type: TypeClick, Position: e.Position, Source: e.Source, Modifiers: e.Modifiers, NumClicks: c.clicks})
+				events = append(events, ClickEvent{Type: TypeClick, Position: e.Position, Source: e.Source, Modifiers: e.Modifiers, Buttons: c.buttons, NumClicks: c.clicks})
 			} else {
 				events = append(events, ClickEvent{Type: TypeCancel})
 			}
@@ -173,8 +241,17 @@ func (c *Click) Events(q event.Queue) []ClickEvent {
 			if c.pressed {
 				break
 			}
-			if e.Source == pointer.Mouse && e.Buttons != pointer.ButtonPrimary {
-				break
+			buttons := pointer.ButtonPrimary
+			if e.Source == pointer.Mouse {
+				// Accept a single button of the set.
+				accept := c.Buttons
+				if accept == 0 {
+					accept = pointer.ButtonPrimary
+				}
+				if e.Buttons&^accept != 0 || e.Buttons&(e.Buttons-1) != 0 || e.Buttons == 0 {
+					break
+				}
+				buttons = e.Buttons
 			}
 			if !c.entered {
 				c.pid = e.PointerID
@@ -183,7 +260,8 @@ func (c *Click) Events(q event.Queue) []ClickEvent {
 				break
 			}
 			c.pressed = true
-			events = append(events, ClickEvent{Type: TypePress, Position: e.Position, Source: e.Source, Modifiers: e.Modifiers})
+			c.buttons = buttons
+			events = append(events, ClickEvent{Type: TypePress, Position: e.Position, Source: e.Source, Modifiers: e.Modifiers, Buttons: buttons})
 		case pointer.Leave:
 			if !c.pressed {
 				c.pid = e.PointerID
@@ -205,6 +283,74 @@ func (c *Click) Events(q event.Queue) []ClickEvent {
 
 func (ClickEvent) ImplementsEvent() {}
 
+// Add the handler to the operation list to receive hover events.
+func (h *Hover) Add(ops *op.Ops) {
+	pointer.InputOp{
+		Tag:   h,
+		Types: pointer.Enter | pointer.Leave | pointer.Move | pointer.Drag,
+	}.Add(ops)
+}
+
+// Update processes the available events and reports whether a
+// pointer is hovering.
+func (h *Hover) Update(q event.Queue) bool {
+	h.entered, h.exited = false, false
+	for _, evt := range q.Events(h) {
+		e, ok := evt.(pointer.Event)
+		if !ok || e.Source == pointer.Touch {
+			continue
+		}
+		switch e.Type {
+		case pointer.Enter:
+			if !h.hovered {
+				h.hovered = true
+				h.entered = true
+				h.pid = e.PointerID
+				h.pos = e.Position
+			}
+		case pointer.Move, pointer.Drag:
+			if h.hovered && h.pid == e.PointerID {
+				h.pos = e.Position
+			}
+		case pointer.Leave:
+			if h.hovered && h.pid == e.PointerID {
+				h.hovered = false
+				h.exited = true
+			}
+		case pointer.Cancel:
+			// The pointer was grabbed by another handler.
+			if h.hovered {
+				h.hovered = false
+				h.exited = true
+			}
+		}
+	}
+	return h.hovered
+}
+
+// Hovered reports whether a pointer is hovering, as of the most
+// recent Update.
+func (h *Hover) Hovered() bool {
+	return h.hovered
+}
+
+// Entered reports whether a pointer started hovering during the most
+// recent Update.
+func (h *Hover) Entered() bool {
+	return h.entered
+}
+
+// Exited reports whether the hovering pointer left during the most
+// recent Update.
+func (h *Hover) Exited() bool {
+	return h.exited
+}
+
+// Position returns the most recent position of the hovering pointer.
+func (h *Hover) Position() f32.Point {
+	return h.pos
+}
+
 // Add the handler to the operation list to receive scroll events.
 func (s *Scroll) Add(ops *op.Ops, bounds image.Rectangle) {
 	oph := pointer.InputOp{
@@ -214,14 +360,15 @@ func (s *Scroll) Add(ops *op.Ops, bounds image.Rectangle) {
 		ScrollBounds: bounds,
 	}
 	oph.Add(ops)
-	if s.flinger.Active() {
+	if s.flinger.Active() || s.wheel != 0 {
 		op.InvalidateOp{}.Add(ops)
 	}
 }
 
-// Stop any remaining fling movement.
+// Stop any remaining fling or wheel movement.
 func (s *Scroll) Stop() {
 	s.flinger = fling.Animation{}
+	s.wheel = 0
 }
 
 // Scroll detects the scrolling distance from the available events and
@@ -231,6 +378,7 @@ func (s *Scroll) Scroll(cfg unit.Metric, q event.Queue, t time.Time, axis Axis)
 		s.axis = axis
 		return 0
 	}
+	s.flinger.Decay = s.Decay
 	total := 0
 	for _, evt := range q.Events(s) {
 		e, ok := evt.(pointer.Event)
@@ -267,15 +415,19 @@ func (s *Scroll) Scroll(cfg unit.Metric, q event.Queue, t time.Time, axis Axis)
 			s.dragging = false
 			s.grab = false
 		case pointer.Scroll:
-			switch s.axis {
-			case Horizontal:
-				s.scroll += e.Scroll.X
-			case Vertical:
-				s.scroll += e.Scroll.Y
+			v := s.val(e.Scroll)
+			line := float32(cfg.Px(s.LineSize))
+			if s.LineSize.V == 0 {
+				line = float32(cfg.Px(wheelLine))
+			}
+			if e.Source != pointer.Mouse || -line < v && v < line {
+				s.scroll += v
+				break
+			}
+			if s.wheel == 0 {
+				s.wheelTime = t
 			}
-			iscroll := int(s.scroll)
-			s.scroll -= float32(iscroll)
-			total += iscroll
+			s.wheel += v
 		case pointer.Drag:
 			if !s.dragging || s.pid != e.PointerID {
 				continue
@@ -295,10 +447,34 @@ func (s *Scroll) Scroll(cfg unit.Metric, q event.Queue, t time.Time, axis Axis)
 			}
 		}
 	}
+	s.scroll += s.tickWheel(t)
+	iscroll := int(s.scroll)
+	s.scroll -= float32(iscroll)
+	total += iscroll
 	total += s.flinger.Tick(t)
 	return total
 }
 
+// tickWheel returns the distance of the wheel animation since the
+// last call.
+func (s *Scroll) tickWheel(t time.Time) float32 {
+	if s.wheel == 0 {
+		return 0
+	}
+	dt := t.Sub(s.wheelTime).Seconds()
+	if dt <= 0 {
+		return 0
+	}
+	s.wheelTime = t
+	rem := s.wheel * float32(math.Exp(-dt/wheelDuration.Seconds()))
+	if -.5 < rem && rem < .5 {
+		rem = 0
+	}
+	d := s.wheel - rem
+	s.wheel = rem
+	return d
+}
+
 func (s *Scroll) val(p f32.Point) float32 {
 	if s.axis == Horizontal {
 		return p.X
@@ -385,6 +561,153 @@ func (d *Drag) Events(cfg unit.Metric, q event.Queue, axis Axis) []pointer.Event
 // Dragging reports whether it's currently in use.
 func (d *Drag) Dragging() bool { return d.dragging }
 
+// Add the handler to the operation list to receive transform
+// events.
+func (t *Transform) Add(ops *op.Ops) {
+	pointer.InputOp{
+		Tag:   t,
+		Grab:  t.grab,
+		Types: pointer.Press | pointer.Drag | pointer.Release,
+	}.Add(ops)
+}
+
+// Update processes the available events and returns the change of
+// the gesture since the previous call. Pointers may be added and
+// removed during a gesture; a single pointer pans, while two or more
+// pointers also zoom and rotate around their center.
+func (t *Transform) Update(cfg unit.Metric, q event.Queue) TransformDelta {
+	var acc f32.Affine2D
+	var origin f32.Point
+	changed := false
+	for _, e := range q.Events(t) {
+		e, ok := e.(pointer.Event)
+		if !ok {
+			continue
+		}
+		switch e.Type {
+		case pointer.Press:
+			if !(e.Buttons == pointer.ButtonPrimary || e.Source == pointer.Touch) {
+				break
+			}
+			if t.index(e.PointerID) == -1 {
+				t.pointers = append(t.pointers, transformPointer{id: e.PointerID, pos: e.Position})
+				t.moved = f32.Point{}
+			}
+		case pointer.Drag:
+			i := t.index(e.PointerID)
+			if i == -1 {
+				break
+			}
+			c0 := t.center()
+			step, c1 := t.move(i, e.Position)
+			if !changed {
+				origin = c0
+				changed = true
+			}
+			acc = step.Mul(acc)
+			if e.Priority < pointer.Grabbed {
+				t.moved = t.moved.Add(c1.Sub(c0))
+				slop := float32(cfg.Px(touchSlop))
+				if len(t.pointers) > 1 || t.moved.X*t.moved.X+t.moved.Y*t.moved.Y > slop*slop {
+					t.grab = true
+				}
+			}
+		case pointer.Release:
+			if i := t.index(e.PointerID); i != -1 {
+				t.pointers = append(t.pointers[:i], t.pointers[i+1:]...)
+			}
+			if len(t.pointers) == 0 {
+				t.grab = false
+			}
+		case pointer.Cancel:
+			t.pointers = t.pointers[:0]
+			t.grab = false
+		}
+	}
+	return decompose(acc, origin)
+}
+
+// Active reports whether any pointers are pressed.
+func (t *Transform) Active() bool {
+	return len(t.pointers) > 0
+}
+
+func (t *Transform) index(id pointer.ID) int {
+	for i, p := range t.pointers {
+		if p.id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// center returns the center of the pointers.
+func (t *Transform) center() f32.Point {
+	var c f32.Point
+	for _, p := range t.pointers {
+		c = c.Add(p.pos)
+	}
+	return c.Mul(1 / float32(len(t.pointers)))
+}
+
+// move pointer i to pos and returns the transformation best
+// matching the pointer movements, along with the new center.
+func (t *Transform) move(i int, pos f32.Point) (f32.Affine2D, f32.Point) {
+	c0 := t.center()
+	old := t.pointers[i].pos
+	t.pointers[i].pos = pos
+	c1 := t.center()
+	step := f32.Affine2D{}
+	if len(t.pointers) > 1 {
+		// Sum the lengths, dot and cross products of the
+		// vectors from the center, before and after the move.
+		var l0, l1, dot, cross float32
+		for j, p := range t.pointers {
+			v0 := p.pos.Sub(c0)
+			if j == i {
+				v0 = old.Sub(c0)
+			}
+			v1 := p.pos.Sub(c1)
+			l0 += length(v0)
+			l1 += length(v1)
+			dot += v0.X*v1.X + v0.Y*v1.Y
+			cross += v0.X*v1.Y - v0.Y*v1.X
+		}
+		if l0 > 0 {
+			s := l1 / l0
+			step = step.Scale(c0, f32.Pt(s, s))
+		}
+		if dot != 0 || cross != 0 {
+			step = step.Rotate(c0, float32(math.Atan2(float64(cross), float64(dot))))
+		}
+	}
+	return step.Offset(c1.Sub(c0)), c1
+}
+
+func length(v f32.Point) float32 {
+	return float32(math.Hypot(float64(v.X), float64(v.Y)))
+}
+
+// decompose a uniform scale, rotation and translation around origin
+// from a.
+func decompose(a f32.Affine2D, origin f32.Point) TransformDelta {
+	sx, hx, ox, hy, sy, oy := a.Elems()
+	// a(p) = R*p + o = R*(p - origin) + origin + pan.
+	ro := f32.Pt(sx*origin.X+hx*origin.Y, hy*origin.X+sy*origin.Y)
+	return TransformDelta{
+		Origin:   origin,
+		Scale:    length(f32.Pt(sx, hy)),
+		Rotation: float32(math.Atan2(float64(hy), float64(sx))),
+		Pan:      f32.Pt(ox, oy).Sub(origin).Add(ro),
+	}
+}
+
+// Affine returns the transformation described by the delta.
+func (d TransformDelta) Affine() f32.Affine2D {
+	a := f32.Affine2D{}.Scale(d.Origin, f32.Pt(d.Scale, d.Scale))
+	return a.Rotate(d.Origin, d.Rotation).Offset(d.Pan)
+}
+
 func (a Axis) String() string {
 	switch a {
 	case Horizontal:
@@ -1,13 +1,18 @@
 package gesture
 
 import (
+	"image"
+	"math"
 	"testing"
 	"time"
 
+	"gioui.org/f32"
 	"gioui.org/io/event"
+	"gioui.org/io/key"
 	"gioui.org/io/pointer"
 	"gioui.org/io/router"
 	"gioui.org/op"
+	"gioui.org/unit"
 )
 
 func TestMouseClicks(t *testing.T) {
@@ -60,6 +65,214 @@ func TestMouseClicks(t *testing.T) {
 	}
 }
 
+func TestMouseClickButtons(t *testing.T) {
+	click := func(c *Click, buttons pointer.Buttons) []ClickEvent {
+		var ops op.Ops
+		c.Add(&ops)
+		var r router.Router
+		r.Frame(&ops)
+		press := pointer.Event{Type: pointer.Press, Source: pointer.Mouse, Buttons: buttons}
+		release := press
+		release.Type = pointer.Release
+		release.Buttons = 0
+		r.Queue(press, release)
+		return filterMouseClicks(c.Events(&r))
+	}
+	var c Click
+	if clicks := click(&c, pointer.ButtonSecondary); len(clicks) != 0 {
+		t.Errorf("default click reported %d secondary clicks", len(clicks))
+	}
+	c.Buttons = pointer.ButtonPrimary | pointer.ButtonSecondary
+	clicks := click(&c, pointer.ButtonSecondary)
+	if len(clicks) != 1 || clicks[0].Buttons != pointer.ButtonSecondary {
+		t.Errorf("got clicks %+v, want a secondary click", clicks)
+	}
+	if clicks := click(&c, pointer.ButtonTertiary); len(clicks) != 0 {
+		t.Errorf("reported %d clicks of a button outside the set", len(clicks))
+	}
+	if clicks := click(&c, pointer.ButtonPrimary|pointer.ButtonSecondary); len(clicks) != 0 {
+		t.Errorf("reported %d clicks of several buttons", len(clicks))
+	}
+}
+
+func TestHover(t *testing.T) {
+	var h Hover
+	var ops op.Ops
+	pointer.Rect(image.Rect(0, 0, 100, 100)).Add(&ops)
+	h.Add(&ops)
+	var r router.Router
+	r.Frame(&ops)
+	move := func(src pointer.Source, x, y float32) {
+		r.Queue(pointer.Event{
+			Type:     pointer.Move,
+			Source:   src,
+			Position: f32.Pt(x, y),
+		})
+	}
+	move(pointer.Mouse, 50, 50)
+	if !h.Update(&r) || !h.Entered() || h.Position() != f32.Pt(50, 50) {
+		t.Errorf("got hovered %v, entered %v at %v", h.Hovered(), h.Entered(), h.Position())
+	}
+	move(pointer.Mouse, 60, 50)
+	if !h.Update(&r) || h.Entered() || h.Position() != f32.Pt(60, 50) {
+		t.Errorf("got hovered %v, entered %v at %v", h.Hovered(), h.Entered(), h.Position())
+	}
+	move(pointer.Mouse, 150, 50)
+	if h.Update(&r) || !h.Exited() {
+		t.Errorf("got hovered %v, exited %v after leaving", h.Hovered(), h.Exited())
+	}
+	r.Queue(pointer.Event{
+		Type:     pointer.Press,
+		Source:   pointer.Touch,
+		Position: f32.Pt(50, 50),
+	})
+	if h.Update(&r) {
+		t.Error("touch pointer hovered")
+	}
+}
+
+func TestScrollWheel(t *testing.T) {
+	var s Scroll
+	var ops op.Ops
+	pointer.Rect(image.Rect(0, 0, 100, 100)).Add(&ops)
+	s.Add(&ops, image.Rect(-1000, -1000, 1000, 1000))
+	var r router.Router
+	r.Frame(&ops)
+	var cfg unit.Metric
+	now := time.Now()
+	scroll := func(mods key.Modifiers, d f32.Point) {
+		r.Queue(pointer.Event{
+			Type:      pointer.Scroll,
+			Source:    pointer.Mouse,
+			Position:  f32.Pt(50, 50),
+			Scroll:    d,
+			Modifiers: mods,
+		})
+	}
+	// Initialize the axis.
+	s.Scroll(cfg, &r, now, Vertical)
+
+	// Small distances are applied directly, keeping the remainder.
+	scroll(0, f32.Pt(0, 2.5))
+	if d := s.Scroll(cfg, &r, now, Vertical); d != 2 {
+		t.Errorf("got scroll %d, expected 2", d)
+	}
+	scroll(0, f32.Pt(0, 2.5))
+	if d := s.Scroll(cfg, &r, now, Vertical); d != 3 {
+		t.Errorf("got scroll %d, expected 3", d)
+	}
+
+	// Wheel steps are animated.
+	scroll(0, f32.Pt(0, 100))
+	total := s.Scroll(cfg, &r, now, Vertical)
+	if total != 0 {
+		t.Errorf("got scroll %d for new wheel step, expected 0", total)
+	}
+	now = now.Add(16 * time.Millisecond)
+	d := s.Scroll(cfg, &r, now, Vertical)
+	if d <= 0 || d >= 100 {
+		t.Errorf("got scroll %d after a frame, expected in (0, 100)", d)
+	}
+	total += d
+	now = now.Add(time.Second)
+	total += s.Scroll(cfg, &r, now, Vertical)
+	if total != 100 {
+		t.Errorf("got total wheel scroll %d, expected 100", total)
+	}
+
+	// Shift scrolls horizontally.
+	s.Scroll(cfg, &r, now, Horizontal)
+	scroll(key.ModShift, f32.Pt(0, 5))
+	if d := s.Scroll(cfg, &r, now, Horizontal); d != 5 {
+		t.Errorf("got shift scroll %d, expected 5", d)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	var tr Transform
+	var ops op.Ops
+	pointer.Rect(image.Rect(0, 0, 100, 100)).Add(&ops)
+	tr.Add(&ops)
+	var r router.Router
+	r.Frame(&ops)
+	var cfg unit.Metric
+	touch := func(typ pointer.Type, id pointer.ID, x, y float32) pointer.Event {
+		return pointer.Event{
+			Type:      typ,
+			Source:    pointer.Touch,
+			PointerID: id,
+			Position:  f32.Pt(x, y),
+		}
+	}
+	r.Queue(
+		touch(pointer.Press, 0, 40, 50),
+		touch(pointer.Press, 1, 60, 50),
+		touch(pointer.Drag, 1, 80, 50),
+	)
+	d := tr.Update(cfg, &r)
+	if want := (TransformDelta{Origin: f32.Pt(50, 50), Scale: 2, Pan: f32.Pt(10, 0)}); d != want {
+		t.Errorf("got pinch %+v, expected %+v", d, want)
+	}
+	if got, want := d.Affine().Transform(f32.Pt(40, 50)), f32.Pt(40, 50); got != want {
+		t.Errorf("got transformed pointer %v, expected %v", got, want)
+	}
+	// Releasing a pointer continues the gesture as a pan.
+	r.Queue(
+		touch(pointer.Release, 1, 80, 50),
+		touch(pointer.Drag, 0, 45, 55),
+	)
+	d = tr.Update(cfg, &r)
+	if d.Scale != 1 || d.Pan != f32.Pt(5, 5) {
+		t.Errorf("got pan %+v, expected a translation by (5, 5)", d)
+	}
+	r.Queue(touch(pointer.Release, 0, 45, 55))
+	tr.Update(cfg, &r)
+	if tr.Active() {
+		t.Error("transform active after releasing all pointers")
+	}
+}
+
+func TestTransformRotate(t *testing.T) {
+	var tr Transform
+	var ops op.Ops
+	pointer.Rect(image.Rect(0, 0, 100, 100)).Add(&ops)
+	tr.Add(&ops)
+	var r router.Router
+	r.Frame(&ops)
+	touch := func(typ pointer.Type, id pointer.ID, x, y float32) pointer.Event {
+		return pointer.Event{
+			Type:      typ,
+			Source:    pointer.Touch,
+			PointerID: id,
+			Position:  f32.Pt(x, y),
+		}
+	}
+	r.Queue(
+		touch(pointer.Press, 0, 40, 50),
+		touch(pointer.Press, 1, 60, 50),
+		touch(pointer.Drag, 1, 50, 60),
+		touch(pointer.Drag, 0, 50, 40),
+	)
+	d := tr.Update(unit.Metric{}, &r)
+	if r := d.Rotation; math.Abs(float64(r)-math.Pi/2) > 1e-3 {
+		t.Errorf("got rotation %v, expected %v", r, math.Pi/2)
+	}
+	if math.Abs(float64(d.Scale)-1) > 1e-3 {
+		t.Errorf("got scale %v, expected 1", d.Scale)
+	}
+	if got := d.Affine().Transform(d.Origin.Add(f32.Pt(10, 0))); length(got.Sub(f32.Pt(50, 60))) > 1e-3 {
+		t.Errorf("got transformed pointer %v, expected (50, 60)", got)
+	}
+	// A single pointer no longer rotates.
+	r.Queue(
+		touch(pointer.Release, 1, 50, 60),
+		touch(pointer.Drag, 0, 60, 40),
+	)
+	if d := tr.Update(unit.Metric{}, &r); d.Rotation != 0 || d.Scale != 1 {
+		t.Errorf("got single pointer delta %+v, expected a pan", d)
+	}
+}
+
 func mouseClickEvents(times ...time.Duration) []event.Event {
 	press := pointer.Event{
 		Type:    pointer.Press,
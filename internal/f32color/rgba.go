@@ -140,6 +140,15 @@ func MulAlpha(c color.NRGBA, alpha uint8) color.NRGBA {
 	return c
 }
 
+// Lerp interpolates between the colors a and b by t in the range
+// [0, 1].
+func Lerp(a, b color.NRGBA, t float32) color.NRGBA {
+	l := func(x, y uint8) uint8 {
+		return uint8(float32(x) + (float32(y)-float32(x))*t + .5)
+	}
+	return color.NRGBA{R: l(a.R, b.R), G: l(a.G, b.G), B: l(a.B, b.B), A: l(a.A, b.A)}
+}
+
 // Disabled blends color towards the luminance and multiplies alpha.
 // Blending towards luminance will desaturate the color.
 // Multiplying alpha blends the color together more with the background.
@@ -36,3 +36,18 @@ func TestLinearToRGBARoundtrip(t *testing.T) {
 		}
 	}
 }
+
+func TestLerp(t *testing.T) {
+	a := color.NRGBA{R: 0x00, G: 0xff, B: 0x10, A: 0x80}
+	b := color.NRGBA{R: 0xff, G: 0x00, B: 0x10, A: 0xff}
+	if got := Lerp(a, b, 0); got != a {
+		t.Errorf("got %v at 0, expected %v", got, a)
+	}
+	if got := Lerp(a, b, 1); got != b {
+		t.Errorf("got %v at 1, expected %v", got, b)
+	}
+	want := color.NRGBA{R: 0x80, G: 0x80, B: 0x10, A: 0xc0}
+	if got := Lerp(a, b, .5); got != want {
+		t.Errorf("got %v at .5, expected %v", got, want)
+	}
+}
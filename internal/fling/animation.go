@@ -11,6 +11,10 @@ import (
 )
 
 type Animation struct {
+	// Decay is the rate of exponential decay of the fling velocity,
+	// per second. Zero means the platform default.
+	Decay float32
+
 	// Current offset in pixels.
 	x float32
 	// Initial time.
@@ -47,6 +51,13 @@ func (f *Animation) Start(c unit.Metric, now time.Time, velocity float32) bool {
 	return true
 }
 
+func defaultDecay() float32 {
+	if runtime.GOOS == "darwin" {
+		return -2 // iOS
+	}
+	return -4.2 // Android and default
+}
+
 func (f *Animation) init(now time.Time, v0 float32) {
 	f.t0 = now
 	f.v0 = v0
@@ -63,11 +74,9 @@ func (f *Animation) Tick(now time.Time) int {
 	if !f.Active() {
 		return 0
 	}
-	var k float32
-	if runtime.GOOS == "darwin" {
-		k = -2 // iOS
-	} else {
-		k = -4.2 // Android and default
+	k := -f.Decay
+	if k == 0 {
+		k = defaultDecay()
 	}
 	t := now.Sub(f.t0)
 	// The acceleration x''(t) of a point mass with a drag
@@ -33,34 +33,46 @@ const (
 	TypeCursor
 	TypePath
 	TypeStroke
+	TypeSemanticLabel
+	TypeSemanticDesc
+	TypeSemanticValue
+	TypeSemanticClass
+	TypeSemanticSelected
+	TypeSemanticDisabled
 )
 
 const (
-	TypeMacroLen           = 1 + 4 + 4
-	TypeCallLen            = 1 + 4 + 4
-	TypeDeferLen           = 1
-	TypeTransformLen       = 1 + 4*6
-	TypeRedrawLen          = 1 + 8
-	TypeImageLen           = 1
-	TypePaintLen           = 1
-	TypeColorLen           = 1 + 4
-	TypeLinearGradientLen  = 1 + 8*2 + 4*2
-	TypeAreaLen            = 1 + 1 + 4*4
-	TypePointerInputLen    = 1 + 1 + 1 + 2*4 + 2*4
-	TypePassLen            = 1 + 1
-	TypeClipboardReadLen   = 1
-	TypeClipboardWriteLen  = 1
-	TypeKeyInputLen        = 1
-	TypeKeyFocusLen        = 1
-	TypeKeySoftKeyboardLen = 1 + 1
-	TypeSaveLen            = 1 + 4
-	TypeLoadLen            = 1 + 1 + 4
-	TypeAuxLen             = 1
-	TypeClipLen            = 1 + 4*4 + 1
-	TypeProfileLen         = 1
-	TypeCursorLen          = 1 + 1
-	TypePathLen            = 1
-	TypeStrokeLen          = 1 + 4
+	TypeMacroLen            = 1 + 4 + 4
+	TypeCallLen             = 1 + 4 + 4
+	TypeDeferLen            = 1
+	TypeTransformLen        = 1 + 4*6
+	TypeRedrawLen           = 1 + 8
+	TypeImageLen            = 1
+	TypePaintLen            = 1
+	TypeColorLen            = 1 + 4
+	TypeLinearGradientLen   = 1 + 8*2 + 4*2
+	TypeAreaLen             = 1 + 1 + 4*4
+	TypePointerInputLen     = 1 + 1 + 1 + 2*4 + 2*4
+	TypePassLen             = 1 + 1
+	TypeClipboardReadLen    = 1
+	TypeClipboardWriteLen   = 1
+	TypeKeyInputLen         = 1 + 1 + 1
+	TypeKeyFocusLen         = 1
+	TypeKeySoftKeyboardLen  = 1 + 1
+	TypeSaveLen             = 1 + 4
+	TypeLoadLen             = 1 + 1 + 4
+	TypeAuxLen              = 1
+	TypeClipLen             = 1 + 4*4 + 1
+	TypeProfileLen          = 1
+	TypeCursorLen           = 1 + 1
+	TypePathLen             = 1
+	TypeStrokeLen           = 1 + 4
+	TypeSemanticLabelLen    = 1
+	TypeSemanticDescLen     = 1
+	TypeSemanticValueLen    = 1
+	TypeSemanticClassLen    = 1 + 1
+	TypeSemanticSelectedLen = 1 + 1
+	TypeSemanticDisabledLen = 1 + 1
 )
 
 // StateMask is a bitmask of state types a load operation
@@ -104,12 +116,18 @@ func (t OpType) Size() int {
 		TypeCursorLen,
 		TypePathLen,
 		TypeStrokeLen,
+		TypeSemanticLabelLen,
+		TypeSemanticDescLen,
+		TypeSemanticValueLen,
+		TypeSemanticClassLen,
+		TypeSemanticSelectedLen,
+		TypeSemanticDisabledLen,
 	}[t-firstOpIndex]
 }
 
 func (t OpType) NumRefs() int {
 	switch t {
-	case TypeKeyInput, TypeKeyFocus, TypePointerInput, TypeProfile, TypeCall, TypeClipboardRead, TypeClipboardWrite, TypeCursor:
+	case TypeKeyInput, TypeKeyFocus, TypePointerInput, TypeProfile, TypeCall, TypeClipboardRead, TypeClipboardWrite, TypeCursor, TypeSemanticLabel, TypeSemanticDesc, TypeSemanticValue:
 		return 1
 	case TypeImage:
 		return 2
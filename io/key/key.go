@@ -6,6 +6,12 @@ Package key implements key and text events and operations.
 The InputOp operations is used for declaring key input handlers. Use
 an implementation of the Queue interface from package ui to receive
 events.
+
+Pressing Tab moves the focus to the next Focusable handler in the order
+of their InputOps, and Shift-Tab to the previous handler, wrapping at
+the ends. Such Tab presses are not delivered as events. Tab presses are
+delivered as usual if no handler is Focusable or the focused handler
+isn't, and plain Tab presses if the focused handler accepts them.
 */
 package key
 
@@ -23,8 +29,21 @@ import (
 // focused key handler.
 type InputOp struct {
 	Tag event.Tag
+	// Hint describes the type of text expected by Tag, for
+	// selecting the on-screen keyboard.
+	Hint InputHint
+	// Focusable makes the handler a stop when moving the focus with
+	// Tab.
+	Focusable bool
+	// AcceptsTab delivers Tab presses without modifiers to the
+	// handler while it is focused, instead of moving the focus.
+	// Shift-Tab still moves the focus.
+	AcceptsTab bool
 }
 
+// InputHint describes the type of text expected by an input handler.
+type InputHint uint8
+
 // SoftKeyboardOp shows or hide the on-screen keyboard, if available.
 // It replaces any previous SoftKeyboardOp.
 type SoftKeyboardOp struct {
@@ -115,6 +134,26 @@ const (
 	NameSpace          = "Space"
 )
 
+const (
+	// HintAny hints that any input is expected.
+	HintAny InputHint = iota
+	// HintText hints that text input is expected. It may activate
+	// auto-correction and suggestions.
+	HintText
+	// HintNumeric hints that numeric input is expected. It may
+	// activate a numeric keypad.
+	HintNumeric
+	// HintEmail hints that email input is expected.
+	HintEmail
+	// HintURL hints that URL input is expected.
+	HintURL
+	// HintTelephone hints that telephone number input is expected.
+	HintTelephone
+	// HintPassword hints that password input is expected. It may
+	// disable suggestions and auto-correction.
+	HintPassword
+)
+
 // Contain reports whether m contains all modifiers
 // in m2.
 func (m Modifiers) Contain(m2 Modifiers) bool {
@@ -127,6 +166,15 @@ func (h InputOp) Add(o *op.Ops) {
 	}
 	data := o.Write1(opconst.TypeKeyInputLen, h.Tag)
 	data[0] = byte(opconst.TypeKeyInput)
+	data[1] = byte(h.Hint)
+	var flags byte
+	if h.Focusable {
+		flags |= 1
+	}
+	if h.AcceptsTab {
+		flags |= 2
+	}
+	data[2] = flags
 }
 
 func (h SoftKeyboardOp) Add(o *op.Ops) {
@@ -50,7 +50,9 @@ type AreaOp struct {
 	rect image.Rectangle
 }
 
-// CursorNameOp sets the cursor for the current area.
+// CursorNameOp sets the cursor for the current area. The cursor is
+// shown while the pointer is over the area and the area has an input
+// handler. The foremost such area takes precedence.
 type CursorNameOp struct {
 	Name CursorName
 }
@@ -15,8 +15,15 @@ type TextInputState uint8
 type keyQueue struct {
 	focus    event.Tag
 	handlers map[event.Tag]*keyHandler
-	reader   ops.Reader
-	state    TextInputState
+	// order is the visible focusable handlers in the order of their
+	// first InputOp, for moving the focus with Tab.
+	order  []event.Tag
+	reader ops.Reader
+	state  TextInputState
+	// hint is the input hint of the focused handler, and
+	// hintChanged whether it changed in the last Frame.
+	hint        key.InputHint
+	hintChanged bool
 }
 
 type keyHandler struct {
@@ -24,6 +31,11 @@ type keyHandler struct {
 	// in the current frame.
 	visible bool
 	new     bool
+	hint    key.InputHint
+	// focusable is set if the handler is a Tab stop, and
+	// acceptsTab if it receives plain Tab presses.
+	focusable  bool
+	acceptsTab bool
 }
 
 const (
@@ -38,6 +50,12 @@ func (q *keyQueue) InputState() TextInputState {
 	return q.state
 }
 
+// InputHint returns the input hint of the focused handler, and
+// whether it changed in the last Frame.
+func (q *keyQueue) InputHint() (key.InputHint, bool) {
+	return q.hint, q.hintChanged
+}
+
 func (q *keyQueue) Frame(root *op.Ops, events *handlerEvents) {
 	if q.handlers == nil {
 		q.handlers = make(map[event.Tag]*keyHandler)
@@ -45,6 +63,7 @@ func (q *keyQueue) Frame(root *op.Ops, events *handlerEvents) {
 	for _, h := range q.handlers {
 		h.visible, h.new = false, false
 	}
+	q.order = q.order[:0]
 	q.reader.Reset(root)
 
 	focus, changed, state := q.resolveFocus(events)
@@ -78,14 +97,68 @@ func (q *keyQueue) Frame(root *op.Ops, events *handlerEvents) {
 		}
 	}
 	q.state = state
+	hint := key.HintAny
+	if h, ok := q.handlers[q.focus]; ok {
+		hint = h.hint
+	}
+	q.hintChanged = hint != q.hint
+	q.hint = hint
 }
 
 func (q *keyQueue) Push(e event.Event, events *handlerEvents) {
+	if e, ok := e.(key.Event); ok && e.Name == key.NameTab && e.State == key.Press && q.traverses() {
+		h := q.handlers[q.focus]
+		switch {
+		case e.Modifiers == 0 && (h == nil || !h.acceptsTab):
+			q.moveFocus(1, events)
+			return
+		case e.Modifiers == key.ModShift:
+			q.moveFocus(-1, events)
+			return
+		}
+	}
 	if q.focus != nil {
 		events.Add(q.focus, e)
 	}
 }
 
+// traverses reports whether Tab presses move the focus, which they do
+// if there are Focusable handlers and no other handler is focused.
+func (q *keyQueue) traverses() bool {
+	if len(q.order) == 0 {
+		return false
+	}
+	h, ok := q.handlers[q.focus]
+	return !ok || h.focusable
+}
+
+// moveFocus moves the focus to the next handler in dir, wrapping at
+// the ends.
+func (q *keyQueue) moveFocus(dir int, events *handlerEvents) {
+	n := len(q.order)
+	if n == 0 {
+		return
+	}
+	next := 0
+	if dir < 0 {
+		next = n - 1
+	}
+	for i, k := range q.order {
+		if k == q.focus {
+			next = (i + dir + n) % n
+			break
+		}
+	}
+	if q.order[next] == q.focus {
+		return
+	}
+	if q.focus != nil {
+		events.Add(q.focus, key.FocusEvent{Focus: false})
+	}
+	q.focus = q.order[next]
+	events.Add(q.focus, key.FocusEvent{Focus: true})
+}
+
 func (q *keyQueue) resolveFocus(events *handlerEvents) (focus event.Tag, changed bool, state TextInputState) {
 	for encOp, ok := q.reader.Decode(); ok; encOp, ok = q.reader.Decode() {
 		switch opconst.OpType(encOp.Data[0]) {
@@ -107,7 +180,13 @@ func (q *keyQueue) resolveFocus(events *handlerEvents) (focus event.Tag, changed
 				h = &keyHandler{new: true}
 				q.handlers[op.Tag] = h
 			}
+			if !h.visible && op.Focusable {
+				q.order = append(q.order, op.Tag)
+			}
 			h.visible = true
+			h.hint = op.Hint
+			h.focusable = op.Focusable
+			h.acceptsTab = op.AcceptsTab
 		}
 	}
 	return
@@ -118,7 +197,10 @@ func decodeKeyInputOp(d []byte, refs []interface{}) key.InputOp {
 		panic("invalid op")
 	}
 	return key.InputOp{
-		Tag: refs[0].(event.Tag),
+		Tag:        refs[0].(event.Tag),
+		Hint:       key.InputHint(d[1]),
+		Focusable:  d[2]&1 != 0,
+		AcceptsTab: d[2]&2 != 0,
 	}
 }
 
@@ -256,6 +256,133 @@ func TestKeyFocusedInvisible(t *testing.T) {
 
 }
 
+func TestKeyTabFocus(t *testing.T) {
+	handlers := make([]int, 3)
+	ops := new(op.Ops)
+	r := new(Router)
+	var shortcuts int
+	key.InputOp{Tag: &shortcuts}.Add(ops)
+	for i := range handlers {
+		key.InputOp{Tag: &handlers[i], Focusable: true}.Add(ops)
+	}
+	r.Frame(ops)
+	tab := func(mods key.Modifiers) {
+		r.Queue(key.Event{Name: key.NameTab, State: key.Press, Modifiers: mods})
+	}
+	for _, tc := range []struct {
+		mods key.Modifiers
+		want int
+	}{
+		{want: 0},
+		{want: 1},
+		{want: 2},
+		// Wrap around the end.
+		{want: 0},
+		// And the start.
+		{mods: key.ModShift, want: 2},
+		{mods: key.ModShift, want: 1},
+	} {
+		tab(tc.mods)
+		assertFocus(t, r, &handlers[tc.want])
+		for i := range handlers {
+			for _, e := range r.Events(&handlers[i]) {
+				if _, ok := e.(key.Event); ok {
+					t.Errorf("handler %d received Tab key event", i)
+				}
+			}
+		}
+	}
+}
+
+func TestKeyAcceptsTab(t *testing.T) {
+	var editor, button int
+	ops := new(op.Ops)
+	r := new(Router)
+	key.InputOp{Tag: &editor, Focusable: true, AcceptsTab: true}.Add(ops)
+	key.InputOp{Tag: &button, Focusable: true}.Add(ops)
+	key.FocusOp{Tag: &editor}.Add(ops)
+	r.Frame(ops)
+	r.Events(&editor)
+	r.Queue(key.Event{Name: key.NameTab, State: key.Press})
+	assertFocus(t, r, &editor)
+	tabs := 0
+	for _, e := range r.Events(&editor) {
+		if e, ok := e.(key.Event); ok && e.Name == key.NameTab {
+			tabs++
+		}
+	}
+	if tabs != 1 {
+		t.Errorf("focused handler received %d Tab presses, expected 1", tabs)
+	}
+	// Shift-Tab moves the focus out of the handler.
+	r.Queue(key.Event{Name: key.NameTab, Modifiers: key.ModShift, State: key.Press})
+	assertFocus(t, r, &button)
+}
+
+func TestKeyTabNotFocusable(t *testing.T) {
+	var modal, button int
+	for _, tc := range []struct {
+		name      string
+		focusable bool
+	}{
+		{name: "alone"},
+		{name: "with focusable", focusable: true},
+	} {
+		ops := new(op.Ops)
+		r := new(Router)
+		key.InputOp{Tag: &modal}.Add(ops)
+		if tc.focusable {
+			key.InputOp{Tag: &button, Focusable: true}.Add(ops)
+		}
+		key.FocusOp{Tag: &modal}.Add(ops)
+		r.Frame(ops)
+		r.Events(&modal)
+		r.Queue(
+			key.Event{Name: key.NameTab, State: key.Press},
+			key.Event{Name: key.NameTab, Modifiers: key.ModShift, State: key.Press},
+		)
+		assertFocus(t, r, &modal)
+		tabs := 0
+		for _, e := range r.Events(&modal) {
+			if e, ok := e.(key.Event); ok && e.Name == key.NameTab {
+				tabs++
+			}
+		}
+		if tabs != 2 {
+			t.Errorf("%s: non-focusable handler received %d Tab presses, expected 2", tc.name, tabs)
+		}
+	}
+}
+
+func TestKeyInputHint(t *testing.T) {
+	var text, email int
+	ops := new(op.Ops)
+	r := new(Router)
+	frame := func(focus event.Tag) {
+		ops.Reset()
+		key.InputOp{Tag: &text}.Add(ops)
+		key.InputOp{Tag: &email, Hint: key.HintEmail}.Add(ops)
+		if focus != nil {
+			key.FocusOp{Tag: focus}.Add(ops)
+		}
+		r.Frame(ops)
+	}
+	assertHint := func(want key.InputHint, wantChanged bool) {
+		t.Helper()
+		if hint, changed := r.TextInputHint(); hint != want || changed != wantChanged {
+			t.Errorf("got hint %v (changed: %v), want %v (changed: %v)", hint, changed, want, wantChanged)
+		}
+	}
+	frame(nil)
+	assertHint(key.HintAny, false)
+	frame(&email)
+	assertHint(key.HintEmail, true)
+	frame(nil)
+	assertHint(key.HintEmail, false)
+	frame(&text)
+	assertHint(key.HintAny, true)
+}
+
 func assertKeyEvent(t *testing.T, events []event.Event, expected bool, expectedInputs ...event.Event) {
 	t.Helper()
 	var evtFocus int
@@ -10,7 +10,9 @@ import (
 	"gioui.org/internal/opconst"
 	"gioui.org/internal/ops"
 	"gioui.org/io/event"
+	"gioui.org/io/key"
 	"gioui.org/io/pointer"
+	"gioui.org/io/semantic"
 	"gioui.org/op"
 )
 
@@ -23,6 +25,9 @@ type pointerQueue struct {
 	pointers []pointerInfo
 	reader   ops.Reader
 
+	// semantics holds the described areas, in operation order.
+	semantics []semanticNode
+
 	// states holds the storage for save/restore ops.
 	states  []collectState
 	scratch []event.Tag
@@ -73,6 +78,9 @@ type areaNode struct {
 	trans f32.Affine2D
 	next  int
 	area  areaOp
+	// semantic is the index plus one of the area description
+	// in pointerQueue.semantics, or zero.
+	semantic int
 }
 
 type areaKind uint8
@@ -174,6 +182,30 @@ func (q *pointerQueue) collectHandlers(r *ops.Reader, events *handlerEvents) {
 				name: encOp.Refs[0].(pointer.CursorName),
 				area: len(q.areas) - 1,
 			})
+		case opconst.TypeSemanticLabel:
+			if d := q.semanticDesc(state.area); d != nil {
+				d.Label = encOp.Refs[0].(string)
+			}
+		case opconst.TypeSemanticDesc:
+			if d := q.semanticDesc(state.area); d != nil {
+				d.Description = encOp.Refs[0].(string)
+			}
+		case opconst.TypeSemanticValue:
+			if d := q.semanticDesc(state.area); d != nil {
+				d.Value = encOp.Refs[0].(string)
+			}
+		case opconst.TypeSemanticClass:
+			if d := q.semanticDesc(state.area); d != nil {
+				d.Class = semantic.ClassOp(encOp.Data[1])
+			}
+		case opconst.TypeSemanticSelected:
+			if d := q.semanticDesc(state.area); d != nil {
+				d.Selected = encOp.Data[1] != 0
+			}
+		case opconst.TypeSemanticDisabled:
+			if d := q.semanticDesc(state.area); d != nil {
+				d.Disabled = encOp.Data[1] != 0
+			}
 		}
 	}
 }
@@ -238,6 +270,7 @@ func (q *pointerQueue) Frame(root *op.Ops, events *handlerEvents) {
 	q.hitTree = q.hitTree[:0]
 	q.areas = q.areas[:0]
 	q.cursors = q.cursors[:0]
+	q.semantics = q.semantics[:0]
 	q.reader.Reset(root)
 	q.collectHandlers(&q.reader, events)
 	for k, h := range q.handlers {
@@ -374,6 +407,11 @@ func (q *pointerQueue) deliverScrollEvent(p *pointerInfo, events *handlerEvents,
 		e.Priority = pointer.Grabbed
 		foremost = false
 	}
+	// Shift turns the vertical wheel into a horizontal one. Swap
+	// before the scroll is distributed by the handler ranges.
+	if e.Modifiers.Contain(key.ModShift) && e.Scroll.X == 0 {
+		e.Scroll.X, e.Scroll.Y = e.Scroll.Y, 0
+	}
 	var sx, sy = e.Scroll.X, e.Scroll.Y
 	for _, k := range p.handlers {
 		if sx == 0 && sy == 0 {
@@ -424,12 +462,14 @@ func (q *pointerQueue) deliverEnterLeaveEvents(p *pointerInfo, events *handlerEv
 	}
 	// Deliver Enter events and update cursor.
 	q.cursor = pointer.CursorDefault
+	hasCursor := false
 	for _, k := range hits {
 		h := q.handlers[k]
-		for i := len(q.cursors) - 1; i >= 0; i-- {
+		// The foremost area with a cursor takes precedence.
+		for i := len(q.cursors) - 1; i >= 0 && !hasCursor; i-- {
 			if c := q.cursors[i]; c.area == h.area {
 				q.cursor = c.name
-				break
+				hasCursor = true
 			}
 		}
 		if _, found := searchTag(p.entered, k); found {
@@ -531,6 +531,35 @@ func TestMultitouch(t *testing.T) {
 	assertEventSequence(t, r.Events(h2), pointer.Cancel, pointer.Enter, pointer.Press, pointer.Release)
 }
 
+func TestCursorNested(t *testing.T) {
+	ops := new(op.Ops)
+	var r Router
+	var parent, child int
+	pointer.Rect(image.Rectangle{Max: image.Pt(100, 100)}).Add(ops)
+	pointer.InputOp{Tag: &parent}.Add(ops)
+	pointer.CursorNameOp{Name: pointer.CursorPointer}.Add(ops)
+	pointer.Rect(image.Rectangle{Max: image.Pt(50, 50)}).Add(ops)
+	pointer.InputOp{Tag: &child}.Add(ops)
+	pointer.CursorNameOp{Name: pointer.CursorText}.Add(ops)
+	r.Frame(ops)
+	for _, tc := range []struct {
+		pos  f32.Point
+		want pointer.CursorName
+	}{
+		{pos: f32.Pt(25, 25), want: pointer.CursorText},
+		{pos: f32.Pt(75, 75), want: pointer.CursorPointer},
+	} {
+		r.Queue(pointer.Event{
+			Type:     pointer.Move,
+			Source:   pointer.Mouse,
+			Position: tc.pos,
+		})
+		if got := r.Cursor(); got != tc.want {
+			t.Errorf("at %v: got cursor %q; want %q", tc.pos, got, tc.want)
+		}
+	}
+}
+
 func TestCursorNameOp(t *testing.T) {
 	ops := new(op.Ops)
 	var r Router
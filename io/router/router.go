@@ -102,6 +102,12 @@ func (q *Router) TextInputState() TextInputState {
 	return q.kqueue.InputState()
 }
 
+// TextInputHint returns the input hint of the focused key handler,
+// and whether it changed in the most recent call to Frame.
+func (q *Router) TextInputHint() (key.InputHint, bool) {
+	return q.kqueue.InputHint()
+}
+
 // WriteClipboard returns the most recent text to be copied
 // to the clipboard, if any.
 func (q *Router) WriteClipboard() (string, bool) {
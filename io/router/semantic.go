@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package router
+
+import (
+	"image"
+	"math"
+
+	"gioui.org/f32"
+	"gioui.org/io/semantic"
+)
+
+// SemanticID identifies a node in the semantic tree of a frame.
+// The zero value identifies no node.
+type SemanticID uint
+
+// SemanticNode is a component described by semantic operations.
+type SemanticNode struct {
+	ID SemanticID
+	// ParentID is the ID of the closest enclosing node, or zero
+	// for top level nodes.
+	ParentID SemanticID
+	Desc     SemanticDesc
+}
+
+// SemanticDesc describes a component.
+type SemanticDesc struct {
+	Class       semantic.ClassOp
+	Label       string
+	Description string
+	Value       string
+	Selected    bool
+	Disabled    bool
+	// Bounds is the bounding box of the component area in
+	// window coordinates.
+	Bounds image.Rectangle
+}
+
+type semanticNode struct {
+	area int
+	desc SemanticDesc
+}
+
+// AppendSemantics appends the semantic tree from the most recent
+// call to Frame to nodes. The nodes are in the order of their
+// operations, so parents appear before their children.
+func (q *Router) AppendSemantics(nodes []SemanticNode) []SemanticNode {
+	pq := &q.pqueue
+	for i, n := range pq.semantics {
+		sn := SemanticNode{
+			ID:   SemanticID(i + 1),
+			Desc: n.desc,
+		}
+		for a := pq.areas[n.area].next; a != -1; a = pq.areas[a].next {
+			if id := pq.areas[a].semantic; id != 0 {
+				sn.ParentID = SemanticID(id)
+				break
+			}
+		}
+		a := pq.areas[n.area]
+		sn.Desc.Bounds = transformBounds(a.trans, a.area.rect)
+		nodes = append(nodes, sn)
+	}
+	return nodes
+}
+
+// semanticDesc returns the description for an area, creating it if
+// necessary. It returns nil if there is no area.
+func (q *pointerQueue) semanticDesc(area int) *SemanticDesc {
+	if area == -1 {
+		return nil
+	}
+	a := &q.areas[area]
+	if a.semantic == 0 {
+		q.semantics = append(q.semantics, semanticNode{area: area})
+		a.semantic = len(q.semantics)
+	}
+	return &q.semantics[a.semantic-1].desc
+}
+
+// transformBounds returns the integer bounding box of r transformed
+// by t.
+func transformBounds(t f32.Affine2D, r f32.Rectangle) image.Rectangle {
+	corners := [...]f32.Point{
+		t.Transform(r.Min),
+		t.Transform(f32.Pt(r.Max.X, r.Min.Y)),
+		t.Transform(r.Max),
+		t.Transform(f32.Pt(r.Min.X, r.Max.Y)),
+	}
+	min, max := corners[0], corners[0]
+	for _, c := range corners[1:] {
+		min.X = float32(math.Min(float64(min.X), float64(c.X)))
+		min.Y = float32(math.Min(float64(min.Y), float64(c.Y)))
+		max.X = float32(math.Max(float64(max.X), float64(c.X)))
+		max.Y = float32(math.Max(float64(max.Y), float64(c.Y)))
+	}
+	return image.Rectangle{
+		Min: image.Pt(int(math.Floor(float64(min.X))), int(math.Floor(float64(min.Y)))),
+		Max: image.Pt(int(math.Ceil(float64(max.X))), int(math.Ceil(float64(max.Y)))),
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package router
+
+import (
+	"image"
+	"reflect"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/pointer"
+	"gioui.org/io/semantic"
+	"gioui.org/op"
+)
+
+func TestSemanticTree(t *testing.T) {
+	ops := new(op.Ops)
+	var r Router
+	pointer.Rect(image.Rectangle{Max: image.Pt(100, 100)}).Add(ops)
+	semantic.DescriptionOp("group").Add(ops)
+	stack := op.Save(ops)
+	op.Offset(f32.Pt(10, 20)).Add(ops)
+	pointer.Rect(image.Rectangle{Max: image.Pt(30, 10)}).Add(ops)
+	semantic.CheckBox.Add(ops)
+	semantic.LabelOp("check").Add(ops)
+	semantic.SelectedOp(true).Add(ops)
+	stack.Load()
+	// Areas without semantics don't create nodes.
+	pointer.Rect(image.Rectangle{Max: image.Pt(50, 50)}).Add(ops)
+	pointer.Rect(image.Rectangle{Max: image.Pt(40, 40)}).Add(ops)
+	semantic.Button.Add(ops)
+	semantic.DisabledOp(true).Add(ops)
+	r.Frame(ops)
+	got := r.AppendSemantics(nil)
+	want := []SemanticNode{
+		{
+			ID: 1,
+			Desc: SemanticDesc{
+				Description: "group",
+				Bounds:      image.Rect(0, 0, 100, 100),
+			},
+		},
+		{
+			ID:       2,
+			ParentID: 1,
+			Desc: SemanticDesc{
+				Class:    semantic.CheckBox,
+				Label:    "check",
+				Selected: true,
+				Bounds:   image.Rect(10, 20, 40, 30),
+			},
+		},
+		{
+			ID:       3,
+			ParentID: 1,
+			Desc: SemanticDesc{
+				Class:    semantic.Button,
+				Disabled: true,
+				Bounds:   image.Rect(0, 0, 40, 40),
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got semantic tree\n%+v\nwant\n%+v", got, want)
+	}
+	// The tree is rebuilt every frame.
+	ops.Reset()
+	r.Frame(ops)
+	if got := r.AppendSemantics(nil); len(got) != 0 {
+		t.Errorf("got %d nodes from an empty frame", len(got))
+	}
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+/*
+Package semantic provides operations for describing the semantics of
+user interface components, for use by accessibility services such as
+screen readers.
+
+Semantic operations apply to the current pointer hit area, set by
+pointer.AreaOp. Components are nested according to their areas, and
+the resulting tree is available from the event router.
+*/
+package semantic
+
+import (
+	"gioui.org/internal/opconst"
+	"gioui.org/op"
+)
+
+// LabelOp provides the text label of a component, such as the text
+// of a button.
+type LabelOp string
+
+// DescriptionOp provides the description of a component, for
+// components without a textual label.
+type DescriptionOp string
+
+// ValueOp provides the value of a component, such as the content
+// of a text field.
+type ValueOp string
+
+// ClassOp provides the role of a component.
+type ClassOp uint8
+
+// SelectedOp describes the checked state of components with a
+// boolean state, such as check boxes.
+type SelectedOp bool
+
+// DisabledOp describes whether a component is disabled.
+type DisabledOp bool
+
+const (
+	Unknown ClassOp = iota
+	Button
+	CheckBox
+	Editor
+	RadioButton
+	Switch
+)
+
+func (l LabelOp) Add(o *op.Ops) {
+	data := o.Write1(opconst.TypeSemanticLabelLen, string(l))
+	data[0] = byte(opconst.TypeSemanticLabel)
+}
+
+func (d DescriptionOp) Add(o *op.Ops) {
+	data := o.Write1(opconst.TypeSemanticDescLen, string(d))
+	data[0] = byte(opconst.TypeSemanticDesc)
+}
+
+func (v ValueOp) Add(o *op.Ops) {
+	data := o.Write1(opconst.TypeSemanticValueLen, string(v))
+	data[0] = byte(opconst.TypeSemanticValue)
+}
+
+func (c ClassOp) Add(o *op.Ops) {
+	data := o.Write(opconst.TypeSemanticClassLen)
+	data[0] = byte(opconst.TypeSemanticClass)
+	data[1] = byte(c)
+}
+
+func (s SelectedOp) Add(o *op.Ops) {
+	data := o.Write(opconst.TypeSemanticSelectedLen)
+	data[0] = byte(opconst.TypeSemanticSelected)
+	if s {
+		data[1] = 1
+	}
+}
+
+func (d DisabledOp) Add(o *op.Ops) {
+	data := o.Write(opconst.TypeSemanticDisabledLen)
+	data[0] = byte(opconst.TypeSemanticDisabled)
+	if d {
+		data[1] = 1
+	}
+}
+
+func (c ClassOp) String() string {
+	switch c {
+	case Unknown:
+		return "Unknown"
+	case Button:
+		return "Button"
+	case CheckBox:
+		return "CheckBox"
+	case Editor:
+		return "Editor"
+	case RadioButton:
+		return "RadioButton"
+	case Switch:
+		return "Switch"
+	default:
+		panic("invalid ClassOp")
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package layout
+
+import (
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+// Cache records the operations of a static widget once, and replays
+// them in later frames until the constraints or metric change, or
+// Invalidate is called.
+//
+// The operations are recorded in a list owned by the Cache, so they
+// survive the Reset of the frame's list. They are replayed relative to
+// the transform and clip in effect at the replay, which means that a
+// recording may be replayed at a different offset, and that clips in
+// the recording intersect with the clip of the replay. Changes to the
+// operation state by the widget don't leak out of the replay.
+//
+// The widget only runs when recording, so it must not handle events,
+// animate, or otherwise depend on anything but its constraints and
+// metric. Input handlers and invalidations in the recording are
+// replayed as is.
+type Cache struct {
+	ops    op.Ops
+	call   op.CallOp
+	dims   Dimensions
+	cs     Constraints
+	metric unit.Metric
+	valid  bool
+}
+
+// Invalidate discards the recording, to be replaced by the next
+// Layout.
+func (c *Cache) Invalidate() {
+	c.valid = false
+}
+
+// Layout replays the recording of w, or records it if the cache is
+// invalid.
+func (c *Cache) Layout(gtx Context, w Widget) Dimensions {
+	if !c.valid || c.cs != gtx.Constraints || c.metric != gtx.Metric {
+		c.ops.Reset()
+		rgtx := gtx
+		rgtx.Ops = &c.ops
+		m := op.Record(&c.ops)
+		c.dims = w(rgtx)
+		c.call = m.Stop()
+		c.cs = gtx.Constraints
+		c.metric = gtx.Metric
+		c.valid = true
+	}
+	defer op.Save(gtx.Ops).Load()
+	c.call.Add(gtx.Ops)
+	return c.dims
+}
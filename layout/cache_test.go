@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package layout
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/op"
+)
+
+func TestCache(t *testing.T) {
+	var (
+		ops   op.Ops
+		r     router.Router
+		cache Cache
+	)
+	tag := new(int)
+	calls := 0
+	w := func(gtx Context) Dimensions {
+		calls++
+		sz := gtx.Constraints.Min
+		pointer.Rect(image.Rectangle{Max: sz}).Add(gtx.Ops)
+		pointer.InputOp{Tag: tag, Types: pointer.Press}.Add(gtx.Ops)
+		return Dimensions{Size: sz}
+	}
+	gtx := Context{Ops: &ops, Constraints: Exact(image.Pt(10, 10))}
+	frame := func(off f32.Point) Dimensions {
+		ops.Reset()
+		op.Offset(off).Add(&ops)
+		dims := cache.Layout(gtx, w)
+		r.Frame(&ops)
+		return dims
+	}
+	press := func(pos f32.Point) bool {
+		r.Queue(
+			pointer.Event{Type: pointer.Press, Position: pos},
+			pointer.Event{Type: pointer.Release, Position: pos},
+		)
+		for _, e := range r.Events(tag) {
+			if e, ok := e.(pointer.Event); ok && e.Type == pointer.Press {
+				return true
+			}
+		}
+		return false
+	}
+	frame(f32.Point{})
+	frame(f32.Point{})
+	if calls != 1 {
+		t.Errorf("widget with unchanged constraints recorded %d times", calls)
+	}
+	// Replaying at an offset moves the recorded handler.
+	if dims := frame(f32.Pt(20, 0)); dims.Size != image.Pt(10, 10) {
+		t.Errorf("replay returned dimensions %v", dims.Size)
+	}
+	if press(f32.Pt(5, 5)) {
+		t.Error("replayed handler received a press at its recorded position")
+	}
+	if !press(f32.Pt(25, 5)) {
+		t.Error("replayed handler received no press at its offset position")
+	}
+	gtx.Constraints = Exact(image.Pt(20, 20))
+	frame(f32.Point{})
+	cache.Invalidate()
+	frame(f32.Point{})
+	if calls != 3 {
+		t.Errorf("got %d recordings after a constraint change and Invalidate, want 3", calls)
+	}
+}
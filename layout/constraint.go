@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package layout
+
+import (
+	"image"
+
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+// Constraint narrows the constraints of a widget to a range of sizes.
+// Zero values leave the corresponding constraint unchanged, and the
+// range is kept within the incoming constraints.
+type Constraint struct {
+	MinWidth, MaxWidth   unit.Value
+	MinHeight, MaxHeight unit.Value
+}
+
+// AspectRatio lays out a widget in the largest size of a ratio that
+// fits the maximum constraints.
+type AspectRatio struct {
+	// Ratio is the width divided by the height. A zero Ratio leaves
+	// the constraints unchanged.
+	Ratio float32
+	// Alignment positions the widget if the minimum constraints are
+	// larger than the sized widget. The zero value is NW.
+	Alignment Direction
+}
+
+// Layout a widget with the narrowed constraints.
+func (c Constraint) Layout(gtx Context, w Widget) Dimensions {
+	cs := gtx.Constraints
+	if c.MinWidth.V != 0 {
+		cs.Min.X = clampInt(gtx.Px(c.MinWidth), cs.Min.X, cs.Max.X)
+	}
+	if c.MinHeight.V != 0 {
+		cs.Min.Y = clampInt(gtx.Px(c.MinHeight), cs.Min.Y, cs.Max.Y)
+	}
+	if c.MaxWidth.V != 0 {
+		cs.Max.X = clampInt(gtx.Px(c.MaxWidth), cs.Min.X, cs.Max.X)
+	}
+	if c.MaxHeight.V != 0 {
+		cs.Max.Y = clampInt(gtx.Px(c.MaxHeight), cs.Min.Y, cs.Max.Y)
+	}
+	gtx.Constraints = cs
+	return w(gtx)
+}
+
+// Layout a widget with exact constraints of the ratio.
+func (a AspectRatio) Layout(gtx Context, w Widget) Dimensions {
+	if a.Ratio <= 0 {
+		return w(gtx)
+	}
+	cs := gtx.Constraints
+	size := image.Pt(cs.Max.X, int(float32(cs.Max.X)/a.Ratio+.5))
+	if size.Y > cs.Max.Y {
+		size = image.Pt(int(float32(cs.Max.Y)*a.Ratio+.5), cs.Max.Y)
+	}
+	size = image.Pt(clampInt(size.X, 0, cs.Max.X), clampInt(size.Y, 0, cs.Max.Y))
+	macro := op.Record(gtx.Ops)
+	gtx.Constraints = Exact(size)
+	dims := w(gtx)
+	call := macro.Stop()
+	bounds := cs.Constrain(dims.Size)
+	defer op.Save(gtx.Ops).Load()
+	p := a.Alignment.Position(dims.Size, bounds)
+	op.Offset(FPt(p)).Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	return Dimensions{
+		Size:     bounds,
+		Baseline: dims.Baseline + bounds.Y - dims.Size.Y - p.Y,
+	}
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		v = min
+	}
+	if v > max {
+		v = max
+	}
+	return v
+}
@@ -26,6 +26,11 @@ type Context struct {
 	Queue event.Queue
 	// Now is the animation time.
 	Now time.Time
+	// Insets is the space along the edges of the constraints taken up
+	// by system decoration, such as notches and translucent system
+	// bars. Widgets that extend to the edges pad their content by
+	// them, for example with SafeInset.
+	Insets system.Insets
 
 	*op.Ops
 }
@@ -40,7 +45,8 @@ type Context struct {
 //     Constraints: Exact(e.Size),
 //   }
 //
-// NewContext calls ops.Reset and adjusts ops for e.Insets.
+// NewContext calls ops.Reset and adjusts ops for e.Insets. Use
+// NewEdgeContext for laying out under the insets.
 func NewContext(ops *op.Ops, e system.FrameEvent) Context {
 	ops.Reset()
 
@@ -67,6 +73,34 @@ func NewContext(ops *op.Ops, e system.FrameEvent) Context {
 	}
 }
 
+// NewEdgeContext is like NewContext, except that it lays out over
+// the entire window and leaves the insets to the Insets of the
+// Context.
+func NewEdgeContext(ops *op.Ops, e system.FrameEvent) Context {
+	ops.Reset()
+	return Context{
+		Ops:         ops,
+		Now:         e.Now,
+		Queue:       e.Queue,
+		Metric:      e.Metric,
+		Insets:      e.Insets,
+		Constraints: Exact(e.Size),
+	}
+}
+
+// SafeInset pads a widget by the Insets of the context, and lays it
+// out without insets.
+func SafeInset(gtx Context, w Widget) Dimensions {
+	in := Inset{
+		Top:    gtx.Insets.Top,
+		Bottom: gtx.Insets.Bottom,
+		Left:   gtx.Insets.Left,
+		Right:  gtx.Insets.Right,
+	}
+	gtx.Insets = system.Insets{}
+	return in.Layout(gtx, w)
+}
+
 // Px maps the value to pixels.
 func (c Context) Px(v unit.Value) int {
 	return c.Metric.Px(v)
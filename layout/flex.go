@@ -16,7 +16,8 @@ type Flex struct {
 	// Spacing controls the distribution of space left after
 	// layout.
 	Spacing Spacing
-	// Alignment is the alignment in the cross axis.
+	// Alignment is the alignment in the cross axis, unless overridden
+	// by FlexChild.Align.
 	Alignment Alignment
 	// WeightSum is the sum of weights used for the weighted
 	// size of Flexed children. If WeightSum is zero, the sum
@@ -28,6 +29,9 @@ type Flex struct {
 type FlexChild struct {
 	flex   bool
 	weight float32
+	// align overrides the Flex alignment if aligned is set.
+	align   Alignment
+	aligned bool
 
 	widget Widget
 
@@ -77,6 +81,36 @@ func Flexed(weight float32, widget Widget) FlexChild {
 	}
 }
 
+// Spring returns a Flex child of empty space that takes up its weight
+// fraction of the space left over from Rigid children, like Flexed.
+// Springs share the space with each other and with Flexed children by
+// weight; for example, a Spring at the start and end of a row center
+// the children between them, and a Spring of weight 2 takes twice the
+// space of a Spring of weight 1.
+func Spring(weight float32) FlexChild {
+	return Flexed(weight, func(gtx Context) Dimensions {
+		return Dimensions{Size: gtx.Constraints.Min}
+	})
+}
+
+// Align returns the child with its alignment in the cross axis
+// overriding the alignment of the Flex. Children aligned on their
+// Baseline align with each other, regardless of the other children.
+func (c FlexChild) Align(a Alignment) FlexChild {
+	c.align = a
+	c.aligned = true
+	return c
+}
+
+// alignment returns the alignment of the child in a Flex aligned by
+// def.
+func (c FlexChild) alignment(def Alignment) Alignment {
+	if c.aligned {
+		return c.align
+	}
+	return def
+}
+
 // Layout a list of children. The position of the children are
 // determined by the specified order, but Rigid children are laid out
 // before Flexed children.
@@ -143,14 +177,37 @@ func (f Flex) Layout(gtx Context, children ...FlexChild) Dimensions {
 		children[i].dims = dims
 	}
 	var maxCross int
-	var maxBaseline int
+	// maxBaseline is the largest distance from the top to the
+	// baseline, of the children aligned on their baselines if any.
+	var maxBaseline, maxAligned int
+	baselined := false
 	for _, child := range children {
 		if c := f.Axis.Convert(child.dims.Size).Y; c > maxCross {
 			maxCross = c
 		}
-		if b := child.dims.Size.Y - child.dims.Baseline; b > maxBaseline {
+		b := child.dims.Size.Y - child.dims.Baseline
+		if b > maxBaseline {
 			maxBaseline = b
 		}
+		if f.Axis == Horizontal && child.alignment(f.Alignment) == Baseline {
+			baselined = true
+			if b > maxAligned {
+				maxAligned = b
+			}
+		}
+	}
+	if baselined {
+		maxBaseline = maxAligned
+		// Make room for the children below the baseline.
+		for _, child := range children {
+			if child.alignment(f.Alignment) != Baseline {
+				continue
+			}
+			b := child.dims.Size.Y - child.dims.Baseline
+			if c := maxBaseline - b + child.dims.Size.Y; c > maxCross {
+				maxCross = c
+			}
+		}
 	}
 	var space int
 	if mainMin > size {
@@ -173,7 +230,7 @@ func (f Flex) Layout(gtx Context, children ...FlexChild) Dimensions {
 		dims := child.dims
 		b := dims.Size.Y - dims.Baseline
 		var cross int
-		switch f.Alignment {
+		switch child.alignment(f.Alignment) {
 		case End:
 			cross = maxCross - f.Axis.Convert(dims.Size).Y
 		case Middle:
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package layout
+
+import (
+	"image"
+
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+// Grid lays out elements in lines of a fixed number of equally sized
+// cells across the list axis. Like List, only the visible lines are
+// laid out.
+type Grid struct {
+	List
+	// Columns is the number of cells in each line. Zero means
+	// one cell per line.
+	Columns int
+	// RowSpacing is the space between lines.
+	RowSpacing unit.Value
+	// ColumnSpacing is the space between the cells of a line.
+	ColumnSpacing unit.Value
+}
+
+// Layout the Grid.
+func (g *Grid) Layout(gtx Context, len int, w ListElement) Dimensions {
+	cols := g.Columns
+	if cols < 1 {
+		cols = 1
+	}
+	lines := (len + cols - 1) / cols
+	rowGap, colGap := gtx.Px(g.RowSpacing), gtx.Px(g.ColumnSpacing)
+	axis := g.Axis
+	return g.List.Layout(gtx, lines, func(gtx Context, line int) Dimensions {
+		cross := axis.Convert(gtx.Constraints.Max).Y
+		cell := (cross - (cols-1)*colGap) / cols
+		if cell < 0 {
+			cell = 0
+		}
+		var size int
+		for c := 0; c < cols; c++ {
+			i := line*cols + c
+			if i >= len {
+				break
+			}
+			stack := op.Save(gtx.Ops)
+			op.Offset(FPt(axis.Convert(image.Pt(0, c*(cell+colGap))))).Add(gtx.Ops)
+			gtx := gtx
+			gtx.Constraints = axis.constraints(0, inf, cell, cell)
+			dims := w(gtx, i)
+			stack.Load()
+			if s := axis.Convert(dims.Size).X; s > size {
+				size = s
+			}
+		}
+		if line < lines-1 {
+			size += rowGap
+		}
+		return Dimensions{Size: axis.Convert(image.Pt(size, cross))}
+	})
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package layout
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+func TestGrid(t *testing.T) {
+	gtx := Context{
+		Ops:         new(op.Ops),
+		Constraints: Exact(image.Pt(100, 50)),
+	}
+	g := Grid{
+		List:          List{Axis: Vertical},
+		Columns:       3,
+		RowSpacing:    unit.Px(5),
+		ColumnSpacing: unit.Px(5),
+	}
+	var laidOut []int
+	var cells []image.Point
+	g.Layout(gtx, 20, func(gtx Context, idx int) Dimensions {
+		laidOut = append(laidOut, idx)
+		cells = append(cells, gtx.Constraints.Max)
+		return Dimensions{Size: image.Pt(gtx.Constraints.Max.X, 20)}
+	})
+	// Lines of 25 pixels fill 50 pixels with 2 lines, 6 cells.
+	if got, want := len(laidOut), 6; got != want {
+		t.Errorf("laid out %d cells, want %d: %v", got, want, laidOut)
+	}
+	if got, want := g.Position.Count, 2; got != want {
+		t.Errorf("got %d visible lines, want %d", got, want)
+	}
+	if got, want := cells[0].X, 30; got != want {
+		t.Errorf("got cell width %d, want %d", got, want)
+	}
+}
@@ -206,7 +206,8 @@ func (d Direction) Position(widget, bounds image.Point) image.Point {
 	return p
 }
 
-// Spacer adds space between widgets.
+// Spacer adds space between widgets. Use Spring for space that
+// absorbs the remaining space of a Flex.
 type Spacer struct {
 	Width, Height unit.Value
 }
@@ -6,7 +6,9 @@ import (
 	"image"
 	"testing"
 
+	"gioui.org/io/system"
 	"gioui.org/op"
+	"gioui.org/unit"
 )
 
 func TestStack(t *testing.T) {
@@ -29,3 +31,182 @@ func TestStack(t *testing.T) {
 		t.Errorf("Stack ignored Expanded size, got %v expected %v", got, exp)
 	}
 }
+
+func TestFlexBaseline(t *testing.T) {
+	gtx := Context{
+		Ops: new(op.Ops),
+		Constraints: Constraints{
+			Max: image.Pt(100, 100),
+		},
+	}
+	// Children with their baselines 10 and 2 pixels from the top.
+	dims := Flex{Alignment: Baseline}.Layout(gtx,
+		Rigid(func(gtx Context) Dimensions {
+			return Dimensions{Size: image.Pt(10, 12), Baseline: 2}
+		}),
+		Rigid(func(gtx Context) Dimensions {
+			return Dimensions{Size: image.Pt(10, 30), Baseline: 28}
+		}),
+	)
+	// The second child moves down by 8 pixels.
+	if got, want := dims.Size, image.Pt(20, 38); got != want {
+		t.Errorf("got size %v, want %v", got, want)
+	}
+	if got, want := dims.Baseline, 28; got != want {
+		t.Errorf("got baseline %d, want %d", got, want)
+	}
+}
+
+func TestFlexChildAlign(t *testing.T) {
+	gtx := Context{
+		Ops: new(op.Ops),
+		Constraints: Constraints{
+			Max: image.Pt(100, 100),
+		},
+	}
+	var labelMin image.Point
+	// A baseline aligned label beside a centered icon in a row laid
+	// out from the top.
+	dims := Flex{}.Layout(gtx,
+		Flexed(1, func(gtx Context) Dimensions {
+			labelMin = gtx.Constraints.Min
+			return Dimensions{Size: image.Pt(labelMin.X, 12), Baseline: 2}
+		}).Align(Baseline),
+		Rigid(func(gtx Context) Dimensions {
+			return Dimensions{Size: image.Pt(40, 24)}
+		}).Align(Middle),
+	)
+	if got, want := labelMin.X, 60; got != want {
+		t.Errorf("aligned Flexed child got width %d, want %d", got, want)
+	}
+	if got, want := dims.Size, image.Pt(100, 24); got != want {
+		t.Errorf("got size %v, want %v", got, want)
+	}
+	// The baseline of the row is the baseline of the label, not of
+	// the icon.
+	if got, want := dims.Baseline, 14; got != want {
+		t.Errorf("got baseline %d, want %d", got, want)
+	}
+}
+
+func TestConstraint(t *testing.T) {
+	gtx := Context{
+		Ops: new(op.Ops),
+		Constraints: Constraints{
+			Min: image.Pt(10, 0),
+			Max: image.Pt(100, 100),
+		},
+	}
+	var got Constraints
+	Constraint{
+		MinWidth: unit.Px(5), MaxWidth: unit.Px(50),
+		MinHeight: unit.Px(20), MaxHeight: unit.Px(200),
+	}.Layout(gtx, func(gtx Context) Dimensions {
+		got = gtx.Constraints
+		return Dimensions{Size: gtx.Constraints.Min}
+	})
+	// The incoming constraints take precedence.
+	want := Constraints{Min: image.Pt(10, 20), Max: image.Pt(50, 100)}
+	if got != want {
+		t.Errorf("got constraints %v, want %v", got, want)
+	}
+}
+
+func TestAspectRatio(t *testing.T) {
+	tests := []struct {
+		cs   Constraints
+		size image.Point
+		dims Dimensions
+	}{
+		{
+			cs:   Constraints{Max: image.Pt(160, 200)},
+			size: image.Pt(160, 90),
+			dims: Dimensions{Size: image.Pt(160, 90)},
+		},
+		{
+			cs:   Constraints{Max: image.Pt(320, 90)},
+			size: image.Pt(160, 90),
+			dims: Dimensions{Size: image.Pt(160, 90)},
+		},
+		{
+			// The minimum constraints are larger than the ratio
+			// allows.
+			cs:   Exact(image.Pt(160, 160)),
+			size: image.Pt(160, 90),
+			dims: Dimensions{Size: image.Pt(160, 160), Baseline: 35},
+		},
+	}
+	for _, test := range tests {
+		gtx := Context{Ops: new(op.Ops), Constraints: test.cs}
+		var size image.Point
+		dims := AspectRatio{Ratio: 16. / 9, Alignment: Center}.Layout(gtx, func(gtx Context) Dimensions {
+			if gtx.Constraints.Min != gtx.Constraints.Max {
+				t.Errorf("got inexact constraints %v", gtx.Constraints)
+			}
+			size = gtx.Constraints.Min
+			return Dimensions{Size: size}
+		})
+		if size != test.size {
+			t.Errorf("%v: got child size %v, want %v", test.cs, size, test.size)
+		}
+		if dims != test.dims {
+			t.Errorf("%v: got dimensions %v, want %v", test.cs, dims, test.dims)
+		}
+	}
+}
+
+func TestFlexSpring(t *testing.T) {
+	gtx := Context{
+		Ops: new(op.Ops),
+		Constraints: Constraints{
+			Max: image.Pt(100, 100),
+		},
+	}
+	var width int
+	dims := Flex{}.Layout(gtx,
+		Rigid(func(gtx Context) Dimensions {
+			return Dimensions{Size: image.Pt(10, 10)}
+		}),
+		Spring(2),
+		Flexed(1, func(gtx Context) Dimensions {
+			width = gtx.Constraints.Min.X
+			return Dimensions{Size: image.Pt(gtx.Constraints.Min.X, 10)}
+		}),
+		Spring(1),
+		Rigid(func(gtx Context) Dimensions {
+			return Dimensions{Size: image.Pt(10, 10)}
+		}),
+	)
+	if got, want := dims.Size, image.Pt(100, 10); got != want {
+		t.Errorf("got size %v, want %v", got, want)
+	}
+	// The 80 pixels between the Rigid children are shared by weight.
+	if got, want := width, 20; got != want {
+		t.Errorf("Flexed child between springs got width %d, want %d", got, want)
+	}
+}
+
+func TestSafeInset(t *testing.T) {
+	e := system.FrameEvent{
+		Size:   image.Pt(100, 200),
+		Insets: system.Insets{Top: unit.Px(24), Bottom: unit.Px(16)},
+	}
+	gtx := NewEdgeContext(new(op.Ops), e)
+	if got, want := gtx.Constraints, Exact(e.Size); got != want {
+		t.Errorf("got constraints %v, want %v", got, want)
+	}
+	var inner Context
+	dims := SafeInset(gtx, func(gtx Context) Dimensions {
+		inner = gtx
+		return Dimensions{Size: gtx.Constraints.Min}
+	})
+	if got, want := inner.Constraints, Exact(image.Pt(100, 160)); got != want {
+		t.Errorf("got inset constraints %v, want %v", got, want)
+	}
+	if inner.Insets != (system.Insets{}) {
+		t.Errorf("inset widget got insets %v", inner.Insets)
+	}
+	if got, want := dims.Size, e.Size; got != want {
+		t.Errorf("got size %v, want %v", got, want)
+	}
+}
@@ -4,11 +4,14 @@ package layout
 
 import (
 	"image"
+	"math"
+	"time"
 
 	"gioui.org/gesture"
 	"gioui.org/io/pointer"
 	"gioui.org/op"
 	"gioui.org/op/clip"
+	"gioui.org/unit"
 )
 
 type scrollChild struct {
@@ -28,10 +31,25 @@ type List struct {
 	ScrollToEnd bool
 	// Alignment is the cross axis alignment of list elements.
 	Alignment Alignment
+	// Deceleration is the rate of exponential decay of the fling
+	// velocity, per second. Zero means the platform default.
+	Deceleration float32
+	// LineSize is the smallest mouse wheel distance that is
+	// animated as a discrete wheel step. Zero means the default of
+	// gesture.Scroll.
+	LineSize unit.Value
 
 	cs          Constraints
 	scroll      gesture.Scroll
 	scrollDelta int
+	// clamped is the offset removed by clamping the position to
+	// the list ends.
+	clamped int
+	// overscroll is the distance dragged past the list ends, negative
+	// at the start and positive at the end.
+	overscroll float32
+	// overscrollTime is the time of the most recent overscroll update.
+	overscrollTime time.Time
 
 	// Position is updated during Layout. To save the list scroll position,
 	// just save Position after Layout finishes. To scroll the list
@@ -44,6 +62,8 @@ type List struct {
 	// maxSize is the total size of visible children.
 	maxSize  int
 	children []scrollChild
+	// skipped is the number of leading children scrolled out of view.
+	skipped int
 	dir      iterationDir
 }
 
@@ -84,6 +104,15 @@ const (
 
 const inf = 1e6
 
+const (
+	// overscrollResistance controls how fast the displacement of an
+	// overscrolled list approaches its maximum.
+	overscrollResistance = 0.55
+	// overscrollDecay is the rate of exponential decay of the
+	// overscroll once released, per second.
+	overscrollDecay = 12
+)
+
 // init prepares the list for iterating through its children with next.
 func (l *List) init(gtx Context, len int) {
 	if l.more() {
@@ -91,6 +120,7 @@ func (l *List) init(gtx Context, len int) {
 	}
 	l.cs = gtx.Constraints
 	l.maxSize = 0
+	l.clamped = 0
 	l.children = l.children[:0]
 	l.len = len
 	l.update(gtx)
@@ -115,6 +145,67 @@ func (l *List) Layout(gtx Context, len int, w ListElement) Dimensions {
 	return l.layout(gtx.Ops, macro)
 }
 
+// LayoutSticky is like Layout for a list of sections, where the
+// elements for which isHeader returns true start a new section and
+// are laid out by header. The header of the section at the start of
+// the list is pinned to the start until pushed out by the header of
+// the following section. Note that header may be called more than
+// once for an index.
+func (l *List) LayoutSticky(gtx Context, len int, isHeader func(index int) bool, header, w ListElement) Dimensions {
+	dims := l.Layout(gtx, len, func(gtx Context, index int) Dimensions {
+		if isHeader(index) {
+			return header(gtx, index)
+		}
+		return w(gtx, index)
+	})
+	first := l.Position.First
+	h := first
+	for h >= 0 && h < len && !isHeader(h) {
+		h--
+	}
+	if h < 0 || h >= len {
+		return dims
+	}
+	mainMax := l.Axis.Convert(gtx.Constraints.Max).X
+	shift := l.overscrollShift(mainMax)
+	// Find the start of the following header, if visible.
+	limit := int(inf)
+	pos := -l.Position.Offset - shift
+	for i, c := range l.children[l.skipped : l.skipped+l.Position.Count] {
+		if idx := first + i; idx > h && isHeader(idx) {
+			limit = pos
+			break
+		}
+		pos += l.Axis.Convert(c.size).X
+	}
+	crossMin, crossMax := l.Axis.crossConstraint(gtx.Constraints)
+	gtx.Constraints = l.Axis.constraints(0, inf, crossMin, crossMax)
+	macro := op.Record(gtx.Ops)
+	hdims := header(gtx, h)
+	call := macro.Stop()
+	sz := l.Axis.Convert(hdims.Size)
+	start := 0
+	if shift < 0 {
+		start = -shift
+	}
+	if limit-sz.X < start {
+		start = limit - sz.X
+	}
+	var cross int
+	maxCross := l.Axis.Convert(dims.Size).Y
+	switch l.Alignment {
+	case End:
+		cross = maxCross - sz.Y
+	case Middle:
+		cross = (maxCross - sz.Y) / 2
+	}
+	defer op.Save(gtx.Ops).Load()
+	clip.Rect(image.Rectangle{Max: dims.Size}).Add(gtx.Ops)
+	op.Offset(FPt(l.Axis.Convert(image.Pt(start, cross)))).Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	return dims
+}
+
 func (l *List) scrollToEnd() bool {
 	return l.ScrollToEnd && !l.Position.BeforeEnd
 }
@@ -124,8 +215,39 @@ func (l *List) Dragging() bool {
 	return l.scroll.State() == gesture.StateDragging
 }
 
+// Overscroll returns the distance dragged past the list ends, in
+// pixels. It is negative at the start and positive at the end.
+func (l *List) Overscroll() float32 {
+	return l.overscroll
+}
+
 func (l *List) update(gtx Context) {
+	l.scroll.Decay = l.Deceleration
+	l.scroll.LineSize = l.LineSize
 	d := l.scroll.Scroll(gtx.Metric, gtx, gtx.Now, gesture.Axis(l.Axis))
+	if l.overscroll != 0 {
+		if l.Dragging() {
+			// Dragging back towards the content first reduces
+			// the overscroll.
+			if o := l.overscroll; o < 0 && d > 0 || o > 0 && d < 0 {
+				o += float32(d)
+				d = 0
+				if o < 0 != (l.overscroll < 0) {
+					d = int(o)
+					o = 0
+				}
+				l.overscroll = o
+			}
+		} else {
+			// Spring back.
+			dt := gtx.Now.Sub(l.overscrollTime).Seconds()
+			l.overscroll *= float32(math.Exp(-overscrollDecay * dt))
+			if -.5 < l.overscroll && l.overscroll < .5 {
+				l.overscroll = 0
+			}
+		}
+	}
+	l.overscrollTime = gtx.Now
 	l.scrollDelta = d
 	l.Position.Offset += d
 }
@@ -164,9 +286,13 @@ func (l *List) nextDir() iterationDir {
 	last := l.Position.First + len(l.children)
 	// Clamp offset.
 	if l.maxSize-l.Position.Offset < vsize && last == l.len {
+		if !l.scrollToEnd() {
+			l.clamped += l.Position.Offset - (l.maxSize - vsize)
+		}
 		l.Position.Offset = l.maxSize - vsize
 	}
 	if l.Position.Offset < 0 && l.Position.First == 0 {
+		l.clamped += l.Position.Offset
 		l.Position.Offset = 0
 	}
 	switch {
@@ -207,6 +333,7 @@ func (l *List) layout(ops *op.Ops, macro op.MacroOp) Dimensions {
 	}
 	mainMin, mainMax := l.Axis.mainConstraint(l.cs)
 	children := l.children
+	l.skipped = 0
 	// Skip invisible children
 	for len(children) > 0 {
 		sz := children[0].size
@@ -218,6 +345,7 @@ func (l *List) layout(ops *op.Ops, macro op.MacroOp) Dimensions {
 		l.Position.First++
 		l.Position.Offset -= mainSize
 		children = children[1:]
+		l.skipped++
 	}
 	size := -l.Position.Offset
 	var maxCross int
@@ -234,7 +362,19 @@ func (l *List) layout(ops *op.Ops, macro op.MacroOp) Dimensions {
 	}
 	l.Position.Count = len(children)
 	l.Position.OffsetLast = mainMax - size
-	pos := -l.Position.Offset
+	// Record the part of a drag past the list ends as overscroll.
+	if l.Dragging() {
+		c, d := l.clamped, l.scrollDelta
+		if c < 0 && d < 0 || c > 0 && d > 0 {
+			// Only the dragged distance counts.
+			if c < d == (c < 0) {
+				c = d
+			}
+			l.overscroll += float32(c)
+		}
+	}
+	shift := l.overscrollShift(mainMax)
+	pos := -l.Position.Offset - shift
 	// ScrollToEnd lists are end aligned.
 	if space := l.Position.OffsetLast; l.ScrollToEnd && space > 0 {
 		pos += space
@@ -269,6 +409,7 @@ func (l *List) layout(ops *op.Ops, macro op.MacroOp) Dimensions {
 		stack.Load()
 		pos += childSize
 	}
+	pos += shift
 	atStart := l.Position.First == 0 && l.Position.Offset <= 0
 	atEnd := l.Position.First+len(children) == l.len && mainMax >= pos
 	if atStart && l.scrollDelta < 0 || atEnd && l.scrollDelta > 0 {
@@ -303,7 +444,25 @@ func (l *List) layout(ops *op.Ops, macro op.MacroOp) Dimensions {
 		Max: l.Axis.Convert(image.Pt(max, 0)),
 	}
 	l.scroll.Add(ops, scrollRange)
+	if l.overscroll != 0 && !l.Dragging() {
+		op.InvalidateOp{}.Add(ops)
+	}
 
 	call.Add(ops)
 	return Dimensions{Size: dims}
 }
+
+// overscrollShift returns the displacement of the list content for
+// the overscroll, approaching a quarter of the viewport size.
+func (l *List) overscrollShift(viewport int) int {
+	o := float64(l.overscroll)
+	if o == 0 || viewport == 0 {
+		return 0
+	}
+	d := float64(viewport)
+	s := (1 - 1/(math.Abs(o)*overscrollResistance/d+1)) * d / 4
+	if o < 0 {
+		s = -s
+	}
+	return int(math.Round(s))
+}
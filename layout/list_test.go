@@ -5,9 +5,11 @@ package layout
 import (
 	"image"
 	"testing"
+	"time"
 
 	"gioui.org/f32"
 	"gioui.org/io/event"
+	"gioui.org/io/key"
 	"gioui.org/io/pointer"
 	"gioui.org/io/router"
 	"gioui.org/op"
@@ -128,3 +130,140 @@ func TestListPosition(t *testing.T) {
 		})
 	}
 }
+
+func TestListOverscroll(t *testing.T) {
+	r := new(router.Router)
+	gtx := Context{
+		Ops:         new(op.Ops),
+		Constraints: Exact(image.Pt(10, 100)),
+		Queue:       r,
+	}
+	el := func(gtx Context, idx int) Dimensions {
+		return Dimensions{Size: image.Pt(10, 20)}
+	}
+	touch := func(typ pointer.Type, y float32) pointer.Event {
+		return pointer.Event{
+			Source:   pointer.Touch,
+			Type:     typ,
+			Position: f32.Pt(5, y),
+		}
+	}
+	list := List{Axis: Vertical}
+	frame := func(e ...event.Event) {
+		r.Frame(gtx.Ops)
+		r.Queue(e...)
+		gtx.Ops.Reset()
+		list.Layout(gtx, 10, el)
+	}
+	frame()
+	// Grab the pointer, then drag down past the list start.
+	frame(touch(pointer.Press, 10), touch(pointer.Drag, 20))
+	frame(touch(pointer.Drag, 60))
+	if list.Position.First != 0 || list.Position.Offset != 0 {
+		t.Errorf("list scrolled past its start: %+v", list.Position)
+	}
+	if list.overscroll >= 0 {
+		t.Fatalf("got overscroll %v, expected negative", list.overscroll)
+	}
+	if s := list.overscrollShift(100); s >= 0 || s < -25 {
+		t.Errorf("got overscroll shift %d, expected in [-25, 0)", s)
+	}
+	// Dragging back reduces the overscroll before scrolling.
+	o := list.overscroll
+	frame(touch(pointer.Drag, 50))
+	if list.overscroll <= o || list.Position.Offset != 0 {
+		t.Errorf("got overscroll %v (was %v), offset %d", list.overscroll, o, list.Position.Offset)
+	}
+	frame(touch(pointer.Release, 50))
+	gtx.Now = gtx.Now.Add(time.Second)
+	frame()
+	if list.overscroll != 0 {
+		t.Errorf("got overscroll %v after release, expected 0", list.overscroll)
+	}
+}
+
+func TestListSticky(t *testing.T) {
+	const n = 20
+	var tags [n]int
+	el := func(gtx Context, idx int) Dimensions {
+		sz := image.Pt(10, 20)
+		defer op.Save(gtx.Ops).Load()
+		pointer.Rect(image.Rectangle{Max: sz}).Add(gtx.Ops)
+		pointer.InputOp{Tag: &tags[idx], Types: pointer.Press}.Add(gtx.Ops)
+		return Dimensions{Size: sz}
+	}
+	isHeader := func(idx int) bool { return idx%5 == 0 }
+	for _, tc := range []struct {
+		label string
+		pos   Position
+		y     float32
+		hit   int
+	}{
+		{label: "pinned", pos: Position{First: 1, Offset: 10}, y: 5, hit: 0},
+		{label: "below pinned", pos: Position{First: 1, Offset: 10}, y: 25, hit: 2},
+		{label: "pushed", pos: Position{First: 4, Offset: 10}, y: 5, hit: 0},
+		{label: "next header", pos: Position{First: 4, Offset: 10}, y: 15, hit: 5},
+		{label: "header at start", pos: Position{First: 5, Offset: 10}, y: 5, hit: 5},
+	} {
+		t.Run(tc.label, func(t *testing.T) {
+			r := new(router.Router)
+			gtx := Context{
+				Ops:         new(op.Ops),
+				Constraints: Exact(image.Pt(10, 100)),
+				Queue:       r,
+			}
+			list := List{Axis: Vertical, Position: tc.pos}
+			list.LayoutSticky(gtx, n, isHeader, el, el)
+			r.Frame(gtx.Ops)
+			r.Queue(pointer.Event{
+				Source:   pointer.Mouse,
+				Buttons:  pointer.ButtonPrimary,
+				Type:     pointer.Press,
+				Position: f32.Pt(5, tc.y),
+			})
+			for i := range tags {
+				hit := false
+				for _, e := range r.Events(&tags[i]) {
+					if e, ok := e.(pointer.Event); ok && e.Type == pointer.Press {
+						hit = true
+					}
+				}
+				if hit != (i == tc.hit) {
+					t.Errorf("element %d: got hit %v", i, hit)
+				}
+			}
+		})
+	}
+}
+
+func TestListShiftScroll(t *testing.T) {
+	r := new(router.Router)
+	gtx := Context{
+		Ops:         new(op.Ops),
+		Constraints: Exact(image.Pt(100, 10)),
+		Queue:       r,
+	}
+	el := func(gtx Context, idx int) Dimensions {
+		return Dimensions{Size: image.Pt(20, 10)}
+	}
+	list := List{Axis: Horizontal}
+	frame := func(e ...event.Event) {
+		r.Frame(gtx.Ops)
+		r.Queue(e...)
+		gtx.Ops.Reset()
+		list.Layout(gtx, 10, el)
+	}
+	frame()
+	// A vertical trackpad scroll with Shift held scrolls the
+	// horizontal list.
+	frame(pointer.Event{
+		Type:      pointer.Scroll,
+		Source:    pointer.Mouse,
+		Modifiers: key.ModShift,
+		Position:  f32.Pt(50, 5),
+		Scroll:    f32.Pt(0, 5),
+	})
+	if got := list.Position.Offset; got != 5 {
+		t.Errorf("got offset %d after a shift scroll, expected 5", got)
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package layout
+
+import (
+	"image"
+
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+// Wrap lays out child elements along an axis, starting a new line
+// whenever the next child doesn't fit the remaining space.
+type Wrap struct {
+	// Axis is the main axis, either Horizontal or Vertical.
+	Axis Axis
+	// Spacing is the space between children of a line.
+	Spacing unit.Value
+	// LineSpacing is the space between lines.
+	LineSpacing unit.Value
+	// LastLineAlignment is the main axis alignment of the last
+	// line, either Start, Middle or End.
+	LastLineAlignment Alignment
+}
+
+type wrapChild struct {
+	call op.CallOp
+	dims Dimensions
+}
+
+// Layout children in lines. Lines are no longer than the maximum
+// constraint of the main axis, except for lines of a single child.
+func (w Wrap) Layout(gtx Context, children ...Widget) Dimensions {
+	var buf [16]wrapChild
+	cs := buf[:0]
+	if len(children) > len(buf) {
+		cs = make([]wrapChild, 0, len(children))
+	}
+	mainMax := w.Axis.Convert(gtx.Constraints.Max).X
+	crossMax := w.Axis.Convert(gtx.Constraints.Max).Y
+	cgtx := gtx
+	cgtx.Constraints = w.Axis.constraints(0, mainMax, 0, crossMax)
+	for _, child := range children {
+		macro := op.Record(gtx.Ops)
+		dims := child(cgtx)
+		cs = append(cs, wrapChild{call: macro.Stop(), dims: dims})
+	}
+	gap, lineGap := gtx.Px(w.Spacing), gtx.Px(w.LineSpacing)
+	var size image.Point
+	for start := 0; start < len(cs); {
+		// Find the children of the line.
+		end := start + 1
+		length := w.Axis.Convert(cs[start].dims.Size).X
+		lineCross := w.Axis.Convert(cs[start].dims.Size).Y
+		for ; end < len(cs); end++ {
+			sz := w.Axis.Convert(cs[end].dims.Size)
+			if length+gap+sz.X > mainMax {
+				break
+			}
+			length += gap + sz.X
+			if sz.Y > lineCross {
+				lineCross = sz.Y
+			}
+		}
+		var pos int
+		if end == len(cs) {
+			switch w.LastLineAlignment {
+			case Middle:
+				pos = (mainMax - length) / 2
+			case End:
+				pos = mainMax - length
+			}
+			if pos < 0 {
+				pos = 0
+			}
+		}
+		if start > 0 {
+			size.Y += lineGap
+		}
+		for _, c := range cs[start:end] {
+			stack := op.Save(gtx.Ops)
+			op.Offset(FPt(w.Axis.Convert(image.Pt(pos, size.Y)))).Add(gtx.Ops)
+			c.call.Add(gtx.Ops)
+			stack.Load()
+			pos += w.Axis.Convert(c.dims.Size).X + gap
+		}
+		if pos -= gap; pos > size.X {
+			size.X = pos
+		}
+		size.Y += lineCross
+		start = end
+	}
+	sz := gtx.Constraints.Constrain(w.Axis.Convert(size))
+	return Dimensions{Size: sz}
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package layout
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+func TestWrap(t *testing.T) {
+	for _, tc := range []struct {
+		align Alignment
+		x     float32
+		hit   int
+	}{
+		// The last line holds children 3 and 4.
+		{align: Start, x: 10, hit: 3},
+		{align: Middle, x: 10, hit: -1},
+		{align: Middle, x: 20, hit: 3},
+		{align: End, x: 90, hit: 4},
+	} {
+		r := new(router.Router)
+		gtx := Context{
+			Ops:         new(op.Ops),
+			Constraints: Constraints{Max: image.Pt(100, 100)},
+			Queue:       r,
+		}
+		var tags [5]int
+		children := make([]Widget, len(tags))
+		for i := range children {
+			tag := &tags[i]
+			children[i] = func(gtx Context) Dimensions {
+				sz := image.Pt(30, 10)
+				defer op.Save(gtx.Ops).Load()
+				pointer.Rect(image.Rectangle{Max: sz}).Add(gtx.Ops)
+				pointer.InputOp{Tag: tag, Types: pointer.Press}.Add(gtx.Ops)
+				return Dimensions{Size: sz}
+			}
+		}
+		dims := Wrap{
+			Spacing:           unit.Px(5),
+			LineSpacing:       unit.Px(5),
+			LastLineAlignment: tc.align,
+		}.Layout(gtx, children...)
+		if got, want := dims.Size, image.Pt(100, 25); got != want {
+			t.Errorf("alignment %v: got size %v, want %v", tc.align, got, want)
+		}
+		r.Frame(gtx.Ops)
+		r.Queue(pointer.Event{
+			Source:   pointer.Mouse,
+			Buttons:  pointer.ButtonPrimary,
+			Type:     pointer.Press,
+			Position: f32.Pt(tc.x, 20),
+		})
+		for i := range tags {
+			hit := false
+			for _, e := range r.Events(&tags[i]) {
+				if e, ok := e.(pointer.Event); ok && e.Type == pointer.Press {
+					hit = true
+				}
+			}
+			if hit != (i == tc.hit) {
+				t.Errorf("alignment %v, x %v: child %d got hit %v", tc.align, tc.x, i, hit)
+			}
+		}
+	}
+}
@@ -20,3 +20,14 @@ func TestOpenPathOutlinePanic(t *testing.T) {
 	p.Line(f32.Pt(10, 10))
 	Outline{Path: p.End()}.Op()
 }
+
+func TestEllipseBounds(t *testing.T) {
+	r := f32.Rect(10, 20, 50, 30)
+	spec := Ellipse(r).Path(new(op.Ops))
+	if got, want := spec.bounds, boundRectF(r); got != want {
+		t.Errorf("got bounds %v, want %v", got, want)
+	}
+	if spec.open {
+		t.Error("ellipse path is open")
+	}
+}
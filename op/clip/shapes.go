@@ -126,37 +126,58 @@ func (c Circle) Add(ops *op.Ops) {
 
 // Path returns the PathSpec for the circle.
 func (c Circle) Path(ops *op.Ops) PathSpec {
+	r := f32.Pt(c.Radius, c.Radius)
+	return Ellipse{Min: c.Center.Sub(r), Max: c.Center.Add(r)}.Path(ops)
+}
+
+// Ellipse represents the largest axis-aligned ellipse that
+// is contained in its bounds.
+type Ellipse f32.Rectangle
+
+// Op returns the op for the ellipse.
+func (e Ellipse) Op(ops *op.Ops) Op {
+	return Outline{Path: e.Path(ops)}.Op()
+}
+
+// Add the ellipse clip.
+func (e Ellipse) Add(ops *op.Ops) {
+	e.Op(ops).Add(ops)
+}
+
+// Path returns the PathSpec for the ellipse.
+func (e Ellipse) Path(ops *op.Ops) PathSpec {
 	var p Path
 	p.Begin(ops)
 
-	center := c.Center
-	r := c.Radius
+	bounds := f32.Rectangle(e)
+	center := bounds.Max.Add(bounds.Min).Mul(.5)
+	rx, ry := bounds.Dx()*.5, bounds.Dy()*.5
 
 	// https://pomax.github.io/bezierinfo/#circles_cubic.
 	const q = 4 * (math.Sqrt2 - 1) / 3
 
-	curve := r * q
-	top := f32.Point{X: center.X, Y: center.Y - r}
+	cx, cy := rx*q, ry*q
+	top := f32.Point{X: center.X, Y: center.Y - ry}
 
 	p.MoveTo(top)
 	p.CubeTo(
-		f32.Point{X: center.X + curve, Y: center.Y - r},
-		f32.Point{X: center.X + r, Y: center.Y - curve},
-		f32.Point{X: center.X + r, Y: center.Y},
+		f32.Point{X: center.X + cx, Y: center.Y - ry},
+		f32.Point{X: center.X + rx, Y: center.Y - cy},
+		f32.Point{X: center.X + rx, Y: center.Y},
 	)
 	p.CubeTo(
-		f32.Point{X: center.X + r, Y: center.Y + curve},
-		f32.Point{X: center.X + curve, Y: center.Y + r},
-		f32.Point{X: center.X, Y: center.Y + r},
+		f32.Point{X: center.X + rx, Y: center.Y + cy},
+		f32.Point{X: center.X + cx, Y: center.Y + ry},
+		f32.Point{X: center.X, Y: center.Y + ry},
 	)
 	p.CubeTo(
-		f32.Point{X: center.X - curve, Y: center.Y + r},
-		f32.Point{X: center.X - r, Y: center.Y + curve},
-		f32.Point{X: center.X - r, Y: center.Y},
+		f32.Point{X: center.X - cx, Y: center.Y + ry},
+		f32.Point{X: center.X - rx, Y: center.Y + cy},
+		f32.Point{X: center.X - rx, Y: center.Y},
 	)
 	p.CubeTo(
-		f32.Point{X: center.X - r, Y: center.Y - curve},
-		f32.Point{X: center.X - curve, Y: center.Y - r},
+		f32.Point{X: center.X - rx, Y: center.Y - cy},
+		f32.Point{X: center.X - cx, Y: center.Y - ry},
 		top,
 	)
 	return p.End()
@@ -79,6 +79,7 @@ type Dash struct {
 	size  uint8 // size of the pattern
 }
 
+// Begin the dash pattern, storing its data into ops.
 func (d *Dash) Begin(ops *op.Ops) {
 	d.ops = ops
 	d.macro = op.Record(ops)
@@ -87,10 +88,13 @@ func (d *Dash) Begin(ops *op.Ops) {
 	data[0] = byte(opconst.TypeAux)
 }
 
+// Phase sets the offset into the pattern where the stroke starts.
 func (d *Dash) Phase(v float32) {
 	d.phase = v
 }
 
+// Dash adds a length to the pattern. Lengths alternate between
+// dashes and gaps, starting with a dash.
 func (d *Dash) Dash(length float32) {
 	if d.size == math.MaxUint8 {
 		panic("clip: dash pattern too large")
@@ -101,6 +105,7 @@ func (d *Dash) Dash(length float32) {
 	d.size++
 }
 
+// End returns a DashSpec ready to use in a Stroke.
 func (d *Dash) End() DashSpec {
 	c := d.macro.Stop()
 	return DashSpec{
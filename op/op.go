@@ -62,6 +62,13 @@ The MacroOp records a list of operations to be executed later:
 	// replay the recorded operations:
 	call.Add(ops)
 
+A recording may be replayed in a different Ops list, and in later
+frames if its own list is not Reset. The recorded operations apply
+to the state in effect at the replay, so transforms in the recording
+compose with the current transform and clips intersect with the
+current clip. See layout.Cache for caching the operations of static
+widgets across frames.
+
 */
 package op
 
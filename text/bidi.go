@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"unicode"
+
+	"golang.org/x/image/math/fixed"
+)
+
+// Direction is the base direction of a paragraph of text.
+type Direction uint8
+
+const (
+	// Auto determines the direction from the first strongly
+	// directional character of the text.
+	Auto Direction = iota
+	// LTR is left-to-right text.
+	LTR
+	// RTL is right-to-left text.
+	RTL
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Auto:
+		return "Auto"
+	case LTR:
+		return "LTR"
+	case RTL:
+		return "RTL"
+	default:
+		panic("unreachable")
+	}
+}
+
+// bidiClass is a simplified Unicode bidirectional character type.
+type bidiClass uint8
+
+const (
+	bidiNeutral bidiClass = iota
+	bidiL
+	bidiR
+	bidiNumber
+	// bidiMark is a non-spacing mark that takes the class of the
+	// preceding character.
+	bidiMark
+)
+
+// rtlScripts are the scripts of strongly right-to-left characters.
+var rtlScripts = []*unicode.RangeTable{
+	unicode.Hebrew, unicode.Arabic, unicode.Syriac, unicode.Thaana,
+	unicode.Nko, unicode.Samaritan, unicode.Mandaic,
+}
+
+func classify(r rune) bidiClass {
+	switch {
+	case unicode.Is(unicode.Mn, r):
+		return bidiMark
+	case unicode.IsDigit(r):
+		return bidiNumber
+	case unicode.In(r, rtlScripts...):
+		return bidiR
+	case unicode.IsLetter(r) || unicode.IsMark(r):
+		return bidiL
+	default:
+		return bidiNeutral
+	}
+}
+
+// ResolveDirection returns d, unless d is Auto, in which case it
+// returns the direction of the first strongly directional character
+// in s. Text without such characters is LTR.
+func ResolveDirection(s string, d Direction) Direction {
+	if d != Auto {
+		return d
+	}
+	for _, r := range s {
+		switch classify(r) {
+		case bidiL:
+			return LTR
+		case bidiR:
+			return RTL
+		}
+	}
+	return LTR
+}
+
+// Reorder returns the line of text l, converted from logical to visual
+// order, in a paragraph of direction dir. Reorder implements a subset
+// of the Unicode bidirectional algorithm without explicit embeddings
+// or isolates. Brackets in right-to-left runs are mirrored. Auto
+// is resolved from the text of l.
+func Reorder(l Layout, dir Direction) Layout {
+	dir = ResolveDirection(l.Text, dir)
+	if dir == LTR && !hasRTL(l.Text) {
+		return l
+	}
+	runes := []rune(l.Text)
+	if len(runes) != len(l.Advances) {
+		return l
+	}
+	levels := bidiLevels(runes, dir)
+	rtl := false
+	for _, lvl := range levels {
+		if lvl > 0 {
+			rtl = true
+			break
+		}
+	}
+	if !rtl {
+		return l
+	}
+	advs := make([]fixed.Int26_6, len(l.Advances))
+	copy(advs, l.Advances)
+	var maxLevel, minOdd uint8 = 0, 255
+	for i, lvl := range levels {
+		if lvl > maxLevel {
+			maxLevel = lvl
+		}
+		if lvl%2 == 1 {
+			if lvl < minOdd {
+				minOdd = lvl
+			}
+			runes[i] = mirror(runes[i])
+		}
+	}
+	// Reverse every sequence at or above each level, from the highest
+	// level to the lowest odd level.
+	for lvl := maxLevel; lvl >= minOdd && lvl > 0; lvl-- {
+		for i := 0; i < len(levels); {
+			if levels[i] < lvl {
+				i++
+				continue
+			}
+			j := i
+			for j < len(levels) && levels[j] >= lvl {
+				j++
+			}
+			for a, b := i, j-1; a < b; a, b = a+1, b-1 {
+				runes[a], runes[b] = runes[b], runes[a]
+				advs[a], advs[b] = advs[b], advs[a]
+				levels[a], levels[b] = levels[b], levels[a]
+			}
+			i = j
+		}
+	}
+	return Layout{Text: string(runes), Advances: advs}
+}
+
+// hasRTL reports whether s contains right-to-left characters.
+func hasRTL(s string) bool {
+	for _, r := range s {
+		if classify(r) == bidiR {
+			return true
+		}
+	}
+	return false
+}
+
+// bidiLevels resolves the embedding level of each rune of a line.
+func bidiLevels(runes []rune, dir Direction) []uint8 {
+	base := uint8(0)
+	if dir == RTL {
+		base = 1
+	}
+	classes := make([]bidiClass, len(runes))
+	prev := bidiNeutral
+	for i, r := range runes {
+		c := classify(r)
+		if c == bidiMark {
+			c = prev
+		}
+		classes[i] = c
+		prev = c
+	}
+	// Numbers take the direction of the preceding strong character.
+	strong := bidiL
+	if base == 1 {
+		strong = bidiR
+	}
+	numberAfterR := make([]bool, len(runes))
+	for i, c := range classes {
+		switch c {
+		case bidiL, bidiR:
+			strong = c
+		case bidiNumber:
+			numberAfterR[i] = strong == bidiR
+		}
+	}
+	// strongAt returns the direction of runes for resolving neutrals,
+	// where numbers count as right-to-left.
+	strongAt := func(i int) bidiClass {
+		switch classes[i] {
+		case bidiNumber:
+			if numberAfterR[i] {
+				return bidiR
+			}
+			return bidiL
+		default:
+			return classes[i]
+		}
+	}
+	levels := make([]uint8, len(runes))
+	lLevel := base + base%2
+	// sos is the direction at both ends of the line.
+	sos := bidiL
+	if base == 1 {
+		sos = bidiR
+	}
+	for i := 0; i < len(runes); {
+		c := strongAt(i)
+		if c == bidiNeutral {
+			// Resolve a sequence of neutrals from its surroundings.
+			j := i
+			for j < len(runes) && strongAt(j) == bidiNeutral {
+				j++
+			}
+			before, after := sos, sos
+			if i > 0 {
+				before = strongAt(i - 1)
+			}
+			if j < len(runes) {
+				after = strongAt(j)
+			}
+			lvl := base
+			if before == after {
+				lvl = lLevel
+				if before == bidiR {
+					lvl = 1
+				}
+			}
+			// Trailing whitespace takes the paragraph level.
+			if j == len(runes) {
+				lvl = base
+			}
+			for k := i; k < j; k++ {
+				levels[k] = lvl
+			}
+			i = j
+			continue
+		}
+		switch {
+		case classes[i] == bidiNumber && numberAfterR[i]:
+			levels[i] = 2
+		case c == bidiR:
+			levels[i] = 1
+		default:
+			levels[i] = lLevel
+		}
+		i++
+	}
+	return levels
+}
+
+// mirror returns the mirrored bracket of r, or r.
+func mirror(r rune) rune {
+	switch r {
+	case '(':
+		return ')'
+	case ')':
+		return '('
+	case '[':
+		return ']'
+	case ']':
+		return '['
+	case '{':
+		return '}'
+	case '}':
+		return '{'
+	case '<':
+		return '>'
+	case '>':
+		return '<'
+	case '«':
+		return '»'
+	case '»':
+		return '«'
+	}
+	return r
+}
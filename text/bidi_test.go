@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"testing"
+
+	"golang.org/x/image/math/fixed"
+)
+
+func TestReorder(t *testing.T) {
+	for _, tc := range []struct {
+		dir     Direction
+		logical string
+		visual  string
+	}{
+		{dir: LTR, logical: "abc def", visual: "abc def"},
+		{dir: LTR, logical: "abc אבג def", visual: "abc גבא def"},
+		{dir: RTL, logical: "אבג abc", visual: "abc גבא"},
+		{dir: RTL, logical: "אבג 123", visual: "123 גבא"},
+		{dir: RTL, logical: "אבג (ד)", visual: "(ד) גבא"},
+		{dir: Auto, logical: "אבג abc", visual: "abc גבא"},
+		{dir: Auto, logical: "abc אבג", visual: "abc גבא"},
+	} {
+		runes := []rune(tc.logical)
+		l := Layout{Text: tc.logical, Advances: make([]fixed.Int26_6, len(runes))}
+		for i, r := range runes {
+			l.Advances[i] = fixed.Int26_6(r)
+		}
+		got := Reorder(l, tc.dir)
+		if got.Text != tc.visual {
+			t.Errorf("Reorder(%q, %v) = %q, want %q", tc.logical, tc.dir, got.Text, tc.visual)
+		}
+		// Advances follow their runes, except for mirrored brackets.
+		for i, r := range []rune(got.Text) {
+			if adv := got.Advances[i]; adv != fixed.Int26_6(r) && adv != fixed.Int26_6(mirror(r)) {
+				t.Errorf("%q: advance %d doesn't match rune %q", tc.logical, i, r)
+			}
+		}
+	}
+}
+
+func TestResolveDirection(t *testing.T) {
+	if d := ResolveDirection("123 אבג", Auto); d != RTL {
+		t.Errorf("got %v, want RTL", d)
+	}
+	if d := ResolveDirection("123", Auto); d != LTR {
+		t.Errorf("got %v, want LTR", d)
+	}
+	if d := ResolveDirection("abc", RTL); d != RTL {
+		t.Errorf("got %v, want RTL", d)
+	}
+}
@@ -27,7 +27,10 @@ type layoutElem struct {
 type path struct {
 	next, prev *path
 	key        pathKey
-	val        op.CallOp
+	// advances of the shaped layout. Layouts of the same text may
+	// differ in their advances, for example when justified.
+	advances []fixed.Int26_6
+	val      op.CallOp
 }
 
 type layoutKey struct {
@@ -60,6 +63,9 @@ func (l *layoutCache) Put(k layoutKey, lt []Line) {
 		l.head.prev = l.tail
 		l.tail.next = l.head
 	}
+	if old, ok := l.m[k]; ok {
+		l.remove(old)
+	}
 	val := &layoutElem{key: k, layout: lt}
 	l.m[k] = val
 	l.insert(val)
@@ -82,8 +88,8 @@ func (l *layoutCache) insert(lt *layoutElem) {
 	lt.next.prev = lt
 }
 
-func (c *pathCache) Get(k pathKey) (op.CallOp, bool) {
-	if v, ok := c.m[k]; ok {
+func (c *pathCache) Get(k pathKey, advances []fixed.Int26_6) (op.CallOp, bool) {
+	if v, ok := c.m[k]; ok && equalAdvances(v.advances, advances) {
 		c.remove(v)
 		c.insert(v)
 		return v.val, true
@@ -91,7 +97,7 @@ func (c *pathCache) Get(k pathKey) (op.CallOp, bool) {
 	return op.CallOp{}, false
 }
 
-func (c *pathCache) Put(k pathKey, v op.CallOp) {
+func (c *pathCache) Put(k pathKey, advances []fixed.Int26_6, v op.CallOp) {
 	if c.m == nil {
 		c.m = make(map[pathKey]*path)
 		c.head = new(path)
@@ -99,7 +105,10 @@ func (c *pathCache) Put(k pathKey, v op.CallOp) {
 		c.head.prev = c.tail
 		c.tail.next = c.head
 	}
-	val := &path{key: k, val: v}
+	if old, ok := c.m[k]; ok {
+		c.remove(old)
+	}
+	val := &path{key: k, advances: advances, val: v}
 	c.m[k] = val
 	c.insert(val)
 	if len(c.m) > maxSize {
@@ -120,3 +129,15 @@ func (c *pathCache) insert(v *path) {
 	v.prev.next = v
 	v.next.prev = v
 }
+
+func equalAdvances(a, b []fixed.Int26_6) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -24,15 +24,31 @@ func TestLayoutLRU(t *testing.T) {
 func TestPathLRU(t *testing.T) {
 	c := new(pathCache)
 	put := func(i int) {
-		c.Put(pathKey{str: strconv.Itoa(i)}, op.CallOp{})
+		c.Put(pathKey{str: strconv.Itoa(i)}, nil, op.CallOp{})
 	}
 	get := func(i int) bool {
-		_, ok := c.Get(pathKey{str: strconv.Itoa(i)})
+		_, ok := c.Get(pathKey{str: strconv.Itoa(i)}, nil)
 		return ok
 	}
 	testLRU(t, put, get)
 }
 
+func TestLRUReplace(t *testing.T) {
+	c := new(layoutCache)
+	k := layoutKey{str: "replaced"}
+	c.Put(k, nil)
+	c.Put(k, []Line{{}})
+	for i := 0; i < maxSize-1; i++ {
+		c.Put(layoutKey{str: strconv.Itoa(i)}, nil)
+	}
+	c.Get(k)
+	// Evict the oldest key, which must not be the replaced key.
+	c.Put(layoutKey{str: strconv.Itoa(maxSize)}, nil)
+	if l, ok := c.Get(k); !ok || len(l) != 1 {
+		t.Errorf("replaced key lost its latest value")
+	}
+}
+
 func testLRU(t *testing.T, put func(i int), get func(i int) bool) {
 	for i := 0; i < maxSize; i++ {
 		put(i)
@@ -6,6 +6,7 @@ import (
 	"io"
 	"strings"
 
+	"golang.org/x/image/font"
 	"golang.org/x/image/math/fixed"
 
 	"gioui.org/op"
@@ -19,6 +20,13 @@ type Shaper interface {
 	LayoutString(font Font, size fixed.Int26_6, maxWidth int, str string) []Line
 	// Shape a line of text and return a clipping operation for its outline.
 	Shape(font Font, size fixed.Int26_6, layout Layout) op.CallOp
+	// Metrics returns the vertical metrics of a font at a size.
+	Metrics(font Font, size fixed.Int26_6) Metrics
+}
+
+// metricsFace is a Face that reports its metrics.
+type metricsFace interface {
+	Metrics(ppem fixed.Int26_6) font.Metrics
 }
 
 // A FontFace is a Font and a matching Face.
@@ -33,14 +41,16 @@ type FontFace struct {
 // If a font matches no registered shape, Cache falls back to the
 // first registered face.
 //
-// The LayoutString and ShapeString results are cached and re-used if
-// possible.
+// The LayoutString and Shape results are cached and re-used if
+// possible. The caches are bounded, and SetFallbacks clears them.
 type Cache struct {
 	def   Typeface
 	faces map[Font]*faceCache
 }
 
 type faceCache struct {
+	// base is the face without fallbacks.
+	base        Face
 	face        Face
 	layoutCache layoutCache
 	pathCache   pathCache
@@ -84,11 +94,25 @@ func NewCache(collection []FontFace) *Cache {
 		if i == 0 {
 			c.def = ff.Font.Typeface
 		}
-		c.faces[ff.Font] = &faceCache{face: ff.Face}
+		c.faces[ff.Font] = &faceCache{base: ff.Face, face: ff.Face}
 	}
 	return c
 }
 
+// SetFallbacks sets the prioritized list of faces that supply glyphs
+// missing from the registered faces, for example emoji or CJK
+// characters. Only registered faces that implement FallbackFace use
+// them.
+func (c *Cache) SetFallbacks(fallbacks ...Face) {
+	for font, f := range c.faces {
+		face := f.base
+		if ff, ok := face.(FallbackFace); ok && len(fallbacks) > 0 {
+			face = ff.WithFallback(fallbacks)
+		}
+		c.faces[font] = &faceCache{base: f.base, face: face}
+	}
+}
+
 // Layout implements the Shaper interface.
 func (s *Cache) Layout(font Font, size fixed.Int26_6, maxWidth int, txt io.Reader) ([]Line, error) {
 	cache := s.lookup(font)
@@ -101,13 +125,35 @@ func (s *Cache) LayoutString(font Font, size fixed.Int26_6, maxWidth int, str st
 	return cache.layout(size, maxWidth, str)
 }
 
-// Shape is a caching implementation of the Shaper interface. Shape assumes that the layout
-// argument is unchanged from a call to Layout or LayoutString.
+// Shape is a caching implementation of the Shaper interface. Layouts
+// of the same text with different advances are shaped separately.
 func (s *Cache) Shape(font Font, size fixed.Int26_6, layout Layout) op.CallOp {
 	cache := s.lookup(font)
 	return cache.shape(size, layout)
 }
 
+// Metrics implements the Shaper interface. For faces that don't report
+// their metrics, the line gap is included in the descent.
+func (s *Cache) Metrics(font Font, size fixed.Int26_6) Metrics {
+	cache := s.lookup(font)
+	if cache == nil {
+		return Metrics{}
+	}
+	if f, ok := cache.face.(metricsFace); ok {
+		m := f.Metrics(size)
+		return Metrics{
+			Ascent:  m.Ascent,
+			Descent: m.Descent,
+			LineGap: m.Height - m.Ascent - m.Descent,
+		}
+	}
+	lines := cache.layout(size, 1e6, "")
+	if len(lines) == 0 {
+		return Metrics{}
+	}
+	return Metrics{Ascent: lines[0].Ascent, Descent: lines[0].Descent}
+}
+
 func (f *faceCache) layout(ppem fixed.Int26_6, maxWidth int, str string) []Line {
 	if f == nil {
 		return nil
@@ -133,10 +179,10 @@ func (f *faceCache) shape(ppem fixed.Int26_6, layout Layout) op.CallOp {
 		ppem: ppem,
 		str:  layout.Text,
 	}
-	if clip, ok := f.pathCache.Get(pk); ok {
+	if clip, ok := f.pathCache.Get(pk, layout.Advances); ok {
 		return clip
 	}
 	clip := f.face.Shape(ppem, layout)
-	f.pathCache.Put(pk, clip)
+	f.pathCache.Put(pk, layout.Advances, clip)
 	return clip
 }
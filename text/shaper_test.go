@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package text
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"golang.org/x/image/math/fixed"
+
+	"gioui.org/op"
+)
+
+// countingFace lays out every rune with an advance of one pixel, and
+// counts its calls.
+type countingFace struct {
+	layouts, shapes int
+}
+
+func (f *countingFace) Layout(ppem fixed.Int26_6, maxWidth int, txt io.Reader) ([]Line, error) {
+	f.layouts++
+	b, err := ioutil.ReadAll(txt)
+	if err != nil {
+		return nil, err
+	}
+	str := string(b)
+	advs := make([]fixed.Int26_6, 0, len(str))
+	for range str {
+		advs = append(advs, fixed.I(1))
+	}
+	return []Line{{Layout: Layout{Text: str, Advances: advs}, Width: fixed.I(len(advs))}}, nil
+}
+
+func (f *countingFace) Shape(ppem fixed.Int26_6, str Layout) op.CallOp {
+	f.shapes++
+	return op.CallOp{}
+}
+
+func TestCacheReuse(t *testing.T) {
+	face := new(countingFace)
+	c := NewCache([]FontFace{{Face: face}})
+	size := fixed.I(10)
+	lines := c.LayoutString(Font{}, size, 100, "text")
+	c.LayoutString(Font{}, size, 100, "text")
+	if face.layouts != 1 {
+		t.Errorf("unchanged text was laid out %d times", face.layouts)
+	}
+	c.LayoutString(Font{}, size, 50, "text")
+	c.LayoutString(Font{}, fixed.I(12), 100, "text")
+	if face.layouts != 3 {
+		t.Errorf("got %d layouts for 3 distinct constraints and sizes", face.layouts)
+	}
+	l := lines[0].Layout
+	c.Shape(Font{}, size, l)
+	c.Shape(Font{}, size, l)
+	if face.shapes != 1 {
+		t.Errorf("unchanged layout was shaped %d times", face.shapes)
+	}
+	// A layout with adjusted advances, such as a justified line,
+	// is not the same shape.
+	adj := Layout{Text: l.Text, Advances: append([]fixed.Int26_6(nil), l.Advances...)}
+	adj.Advances[0] += fixed.I(3)
+	c.Shape(Font{}, size, adj)
+	if face.shapes != 2 {
+		t.Errorf("layout with different advances re-used the cached shape")
+	}
+	c.SetFallbacks()
+	c.LayoutString(Font{}, size, 100, "text")
+	if face.layouts != 4 {
+		t.Errorf("SetFallbacks didn't clear the layout cache")
+	}
+}
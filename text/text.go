@@ -24,6 +24,22 @@ type Line struct {
 	Bounds fixed.Rectangle26_6
 }
 
+// Metrics are the vertical measurements of a font at a particular size.
+type Metrics struct {
+	// Ascent is the distance from the baseline to the top of a line.
+	Ascent fixed.Int26_6
+	// Descent is the distance from the baseline to the bottom of a
+	// line, excluding the line gap.
+	Descent fixed.Int26_6
+	// LineGap is the recommended space between lines.
+	LineGap fixed.Int26_6
+}
+
+// LineHeight returns the distance between the baselines of lines.
+func (m Metrics) LineHeight() fixed.Int26_6 {
+	return m.Ascent + m.Descent + m.LineGap
+}
+
 type Layout struct {
 	Text     string
 	Advances []fixed.Int26_6
@@ -52,6 +68,15 @@ type Face interface {
 	Shape(ppem fixed.Int26_6, str Layout) op.CallOp
 }
 
+// FallbackFace is a Face that can draw the glyphs it lacks from
+// other faces.
+type FallbackFace interface {
+	Face
+	// WithFallback returns a face that draws each glyph from the
+	// first of the receiver and the fallbacks that supports it.
+	WithFallback(fallbacks []Face) Face
+}
+
 // Typeface identifies a particular typeface design. The empty
 // string denotes the default typeface.
 type Typeface string
@@ -65,6 +90,10 @@ const (
 	Start Alignment = iota
 	End
 	Middle
+	// Justify stretches the spaces between words to align every line
+	// but the last line of a paragraph to both edges. Text that
+	// doesn't support justification aligns to Start instead.
+	Justify
 )
 
 const (
@@ -86,6 +115,8 @@ func (a Alignment) String() string {
 		return "End"
 	case Middle:
 		return "Middle"
+	case Justify:
+		return "Justify"
 	default:
 		panic("unreachable")
 	}
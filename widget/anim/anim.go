@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+// Package anim implements helpers for animating widgets: tweens of
+// fixed duration shaped by easing functions, and springs.
+package anim
+
+import (
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Easing maps the linear progress of an animation in the range [0, 1]
+// to its eased progress.
+type Easing func(t float32) float32
+
+// Linear is the identity easing.
+func Linear(t float32) float32 {
+	return t
+}
+
+// EaseIn starts slowly and accelerates.
+func EaseIn(t float32) float32 {
+	return t * t
+}
+
+// EaseOut starts quickly and decelerates.
+func EaseOut(t float32) float32 {
+	return 1 - (1-t)*(1-t)
+}
+
+// EaseInOut accelerates until halfway, then decelerates.
+func EaseInOut(t float32) float32 {
+	if t < .5 {
+		return 2 * t * t
+	}
+	t = 1 - t
+	return 1 - 2*t*t
+}
+
+// Smoothstep is the cubic ease-in-out t²(3-2t).
+func Smoothstep(t float32) float32 {
+	return t * t * (3 - 2*t)
+}
+
+// Animation is a transition of Duration from Start.
+type Animation struct {
+	Start    time.Time
+	Duration time.Duration
+	// Easing shapes the progress. A nil Easing means Linear.
+	Easing Easing
+}
+
+// Begin restarts the animation at now.
+func (a *Animation) Begin(now time.Time) {
+	a.Start = now
+}
+
+// Finished reports whether the animation has completed at now. An
+// animation that never began is finished.
+func (a Animation) Finished(now time.Time) bool {
+	return a.Start.IsZero() || now.Sub(a.Start) >= a.Duration
+}
+
+// Progress returns the eased progress at gtx.Now, from 0 at Start to
+// 1 when finished, and requests a frame while the animation runs.
+func (a Animation) Progress(gtx layout.Context) float32 {
+	if a.Finished(gtx.Now) {
+		return 1
+	}
+	op.InvalidateOp{}.Add(gtx.Ops)
+	t := float32(gtx.Now.Sub(a.Start).Seconds() / a.Duration.Seconds())
+	if t < 0 {
+		t = 0
+	}
+	if a.Easing == nil {
+		return t
+	}
+	return a.Easing(t)
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package anim
+
+import (
+	"testing"
+	"time"
+
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestEasings(t *testing.T) {
+	for name, ease := range map[string]Easing{
+		"Linear":     Linear,
+		"EaseIn":     EaseIn,
+		"EaseOut":    EaseOut,
+		"EaseInOut":  EaseInOut,
+		"Smoothstep": Smoothstep,
+	} {
+		if got := ease(0); got != 0 {
+			t.Errorf("%s(0) = %v, expected 0", name, got)
+		}
+		if got := ease(1); got != 1 {
+			t.Errorf("%s(1) = %v, expected 1", name, got)
+		}
+		prev := float32(0)
+		for i := 1; i <= 10; i++ {
+			v := ease(float32(i) / 10)
+			if v < prev {
+				t.Errorf("%s decreases at %v", name, float32(i)/10)
+			}
+			prev = v
+		}
+	}
+}
+
+func TestAnimation(t *testing.T) {
+	var o op.Ops
+	start := time.Unix(0, 0)
+	gtx := layout.Context{Ops: &o, Now: start}
+	a := Animation{Duration: time.Second, Easing: EaseIn}
+	if !a.Finished(gtx.Now) {
+		t.Error("animation not finished before Begin")
+	}
+	a.Begin(start)
+	gtx.Now = start.Add(500 * time.Millisecond)
+	if got := a.Progress(gtx); got != .25 {
+		t.Errorf("got progress %v halfway, expected .25", got)
+	}
+	if !invalidated(&o) {
+		t.Error("running animation didn't request a frame")
+	}
+	o.Reset()
+	gtx.Now = start.Add(time.Second)
+	if got := a.Progress(gtx); got != 1 {
+		t.Errorf("got progress %v at the end, expected 1", got)
+	}
+	if !a.Finished(gtx.Now) || invalidated(&o) {
+		t.Error("finished animation requested a frame")
+	}
+}
+
+func invalidated(o *op.Ops) bool {
+	var r router.Router
+	r.Frame(o)
+	_, ok := r.WakeupTime()
+	return ok
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package anim
+
+import (
+	"math"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Spring animates a value towards Target by the physics of a damped
+// spring of unit mass. Unlike an Animation, a spring has no fixed
+// duration, and a change of Target mid-flight keeps the velocity.
+type Spring struct {
+	// Target is the value of the spring at rest.
+	Target float32
+	// Stiffness is the force per unit of distance from Target. If
+	// zero, DefaultStiffness is used.
+	Stiffness float32
+	// Damping is the damping ratio: 1 is critical damping, the
+	// fastest motion without overshoot, and smaller ratios bounce.
+	// If zero, a ratio of 1 is used.
+	Damping float32
+	// Precision is the distance from Target and the speed at which
+	// the spring comes to rest. If zero, a precision of 0.001 is
+	// used.
+	Precision float32
+
+	value    float32
+	velocity float32
+	last     time.Time
+}
+
+// DefaultStiffness is the stiffness of a Spring with zero Stiffness.
+const DefaultStiffness = 400
+
+const (
+	// springStep is the largest time step of the integration.
+	springStep = 4 * time.Millisecond
+	// springMaxDelta limits the time integrated by an Update, for
+	// example after the window was hidden.
+	springMaxDelta = time.Second
+)
+
+// Value returns the current value of the spring.
+func (s *Spring) Value() float32 {
+	return s.value
+}
+
+// Velocity returns the current speed of the value per second.
+func (s *Spring) Velocity() float32 {
+	return s.velocity
+}
+
+// Set moves the value to v, and stops the spring.
+func (s *Spring) Set(v float32) {
+	s.value = v
+	s.velocity = 0
+}
+
+// Resting reports whether the spring has come to rest at Target.
+func (s *Spring) Resting() bool {
+	p := s.Precision
+	if p == 0 {
+		p = .001
+	}
+	d := s.value - s.Target
+	return -p < d && d < p && -p < s.velocity && s.velocity < p
+}
+
+// Update integrates the motion of the spring until gtx.Now and returns
+// the value. A frame is requested until the spring comes to rest.
+func (s *Spring) Update(gtx layout.Context) float32 {
+	dt := gtx.Now.Sub(s.last)
+	if s.last.IsZero() || dt < 0 {
+		dt = 0
+	}
+	s.last = gtx.Now
+	if s.Resting() {
+		s.rest()
+		return s.value
+	}
+	if dt > springMaxDelta {
+		dt = springMaxDelta
+	}
+	k := s.Stiffness
+	if k == 0 {
+		k = DefaultStiffness
+	}
+	ratio := s.Damping
+	if ratio == 0 {
+		ratio = 1
+	}
+	c := 2 * ratio * float32(math.Sqrt(float64(k)))
+	for dt > 0 {
+		step := dt
+		if step > springStep {
+			step = springStep
+		}
+		dt -= step
+		h := float32(step.Seconds())
+		// Semi-implicit Euler integration.
+		a := -k*(s.value-s.Target) - c*s.velocity
+		s.velocity += a * h
+		s.value += s.velocity * h
+	}
+	if s.Resting() {
+		s.rest()
+	} else {
+		op.InvalidateOp{}.Add(gtx.Ops)
+	}
+	return s.value
+}
+
+// rest stops the spring at Target. No frames are drawn for a resting
+// spring, so its motion starts over from the next Update.
+func (s *Spring) rest() {
+	s.Set(s.Target)
+	s.last = time.Time{}
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package anim
+
+import (
+	"testing"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestSpring(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		damping float32
+		// overshoot is whether the value passes the target.
+		overshoot bool
+	}{
+		{name: "critical", damping: 1},
+		{name: "bouncy", damping: .3, overshoot: true},
+	} {
+		var o op.Ops
+		gtx := layout.Context{Ops: &o, Now: time.Unix(0, 0)}
+		s := Spring{Target: 1, Damping: tc.damping}
+		max := float32(0)
+		i := 0
+		for ; i < 200; i++ {
+			o.Reset()
+			v := s.Update(gtx)
+			if v > max {
+				max = v
+			}
+			if s.Resting() {
+				break
+			}
+			if !invalidated(&o) {
+				t.Fatalf("%s: moving spring didn't request a frame", tc.name)
+			}
+			gtx.Now = gtx.Now.Add(16 * time.Millisecond)
+		}
+		if !s.Resting() || s.Value() != 1 {
+			t.Errorf("%s: spring at %v didn't come to rest", tc.name, s.Value())
+		}
+		if got := max > 1; got != tc.overshoot {
+			t.Errorf("%s: got maximum value %v", tc.name, max)
+		}
+		if invalidated(&o) {
+			t.Errorf("%s: resting spring requested a frame", tc.name)
+		}
+	}
+}
+
+func TestSpringIdle(t *testing.T) {
+	var o op.Ops
+	gtx := layout.Context{Ops: &o, Now: time.Unix(0, 0)}
+	var s Spring
+	s.Update(gtx)
+	// No frames are drawn while the spring rests.
+	gtx.Now = gtx.Now.Add(5 * time.Second)
+	s.Target = 1
+	o.Reset()
+	if v := s.Update(gtx); v != 0 {
+		t.Errorf("spring jumped to %v after an idle period", v)
+	}
+	if s.Resting() || !invalidated(&o) {
+		t.Fatal("spring didn't start moving")
+	}
+	gtx.Now = gtx.Now.Add(16 * time.Millisecond)
+	if v := s.Update(gtx); v <= 0 || v >= 1 {
+		t.Errorf("got %v after a frame, expected in (0, 1)", v)
+	}
+}
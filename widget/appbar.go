@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+// AppBar holds the state of the overflow menu of an app bar, for the
+// actions that don't fit the bar.
+type AppBar struct {
+	// Overflow is the button that opens the menu.
+	Overflow Clickable
+	Menu     Menu
+}
@@ -1,6 +1,8 @@
 package widget
 
 import (
+	"time"
+
 	"gioui.org/layout"
 )
 
@@ -9,7 +11,8 @@ type Bool struct {
 
 	clk Clickable
 
-	changed bool
+	changed    bool
+	changeTime time.Time
 }
 
 // Changed reports whether Value has changed since the last
@@ -34,11 +37,31 @@ func (b *Bool) History() []Press {
 	return b.clk.History()
 }
 
+// ChangeTime returns the time of the most recent change of Value by
+// user interaction. It is useful for animating the transition
+// between values.
+func (b *Bool) ChangeTime() time.Time {
+	return b.changeTime
+}
+
+// Focus requests the input focus for the element. A focused
+// element toggles Value when Space or Enter is pressed.
+func (b *Bool) Focus() {
+	b.clk.Focus()
+}
+
+// Focused reports whether the element has the input focus.
+func (b *Bool) Focused() bool {
+	return b.clk.Focused()
+}
+
 func (b *Bool) Layout(gtx layout.Context) layout.Dimensions {
+	b.clk.Focusable = true
 	dims := b.clk.Layout(gtx)
 	for b.clk.Clicked() {
 		b.Value = !b.Value
 		b.changed = true
+		b.changeTime = gtx.Now
 	}
 	return dims
 }
@@ -17,10 +17,18 @@ type Border struct {
 	Color        color.NRGBA
 	CornerRadius unit.Value
 	Width        unit.Value
+	// Inset lays out the widget inset by the border width, so the
+	// border does not overlap it.
+	Inset bool
 }
 
 func (b Border) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
-	dims := w(gtx)
+	var dims layout.Dimensions
+	if b.Inset {
+		dims = layout.UniformInset(b.Width).Layout(gtx, w)
+	} else {
+		dims = w(gtx)
+	}
 	sz := layout.FPt(dims.Size)
 
 	rr := float32(gtx.Px(b.CornerRadius))
@@ -12,10 +12,25 @@ import (
 	"gioui.org/io/pointer"
 	"gioui.org/layout"
 	"gioui.org/op"
+	"gioui.org/unit"
 )
 
 // Clickable represents a clickable area.
 type Clickable struct {
+	// LongPressDuration is the duration a press must be held
+	// without moving to be reported by LongPressed. If zero, a
+	// default of 500ms is used.
+	LongPressDuration time.Duration
+	// Buttons is the set of mouse buttons reported as clicks, for
+	// example ButtonPrimary | ButtonSecondary for an element with a
+	// context menu. Zero means ButtonPrimary. Only primary presses
+	// are recorded in the History, and only they become long presses.
+	Buttons pointer.Buttons
+	// Focusable makes the element a stop when moving the keyboard
+	// focus with Tab. A focused element reports Space and Enter
+	// presses as clicks regardless.
+	Focusable bool
+
 	click  gesture.Click
 	clicks []Click
 	// prevClicks is the index into clicks that marks the clicks
@@ -23,14 +38,54 @@ type Clickable struct {
 	// clicks bounded.
 	prevClicks int
 	history    []Press
+
+	eventKey     int
+	focused      bool
+	requestFocus bool
+	// keys are the key presses of the most recent update not
+	// handled by the element.
+	keys []key.Event
+
+	longPress struct {
+		// pending is set while the current press may still
+		// become a long press.
+		pending bool
+		// fired is set if the current press was reported as a
+		// long press.
+		fired bool
+		// reported is set until the next Layout after a long press.
+		reported bool
+		start    time.Time
+		pos      f32.Point
+	}
+
+	// numClicks and clickPos track the successive clicks at
+	// approximately the same position.
+	numClicks int
+	clickPos  f32.Point
 }
 
 // Click represents a click.
 type Click struct {
 	Modifiers key.Modifiers
+	// Buttons is the button of the click. Keyboard and programmatic
+	// clicks are primary.
+	Buttons pointer.Buttons
+	// NumClicks is the number of successive clicks within a short
+	// duration and distance of each other, starting at 1.
 	NumClicks int
 }
 
+const (
+	defaultLongPressDuration = 500 * time.Millisecond
+	// longPressSlop is the distance a pointer may move before a
+	// press no longer counts as a long press.
+	longPressSlop = 8
+	// multiClickSlop is the maximum distance between successive
+	// clicks counted together.
+	multiClickSlop = 8
+)
+
 // Press represents a past pointer press.
 type Press struct {
 	// Position of the press.
@@ -48,6 +103,7 @@ type Press struct {
 func (b *Clickable) Click() {
 	b.clicks = append(b.clicks, Click{
 		Modifiers: 0,
+		Buttons:   pointer.ButtonPrimary,
 		NumClicks: 1,
 	})
 }
@@ -66,7 +122,9 @@ func (b *Clickable) Clicked() bool {
 	return true
 }
 
-// Hovered returns whether pointer is over the element.
+// Hovered returns whether pointer is over the element. The hover
+// state is cleared when the pointer leaves, and when the element is
+// laid out again after an absence.
 func (b *Clickable) Hovered() bool {
 	return b.click.Hovered()
 }
@@ -76,6 +134,26 @@ func (b *Clickable) Pressed() bool {
 	return b.click.Pressed()
 }
 
+// LongPressed reports whether the element was pressed and held for
+// LongPressDuration since the last call to LongPressed. The release
+// of a long press is not reported as a click.
+func (b *Clickable) LongPressed() bool {
+	lp := b.longPress.reported
+	b.longPress.reported = false
+	return lp
+}
+
+// Focus requests the input focus for the element. A focused
+// element reports a click when Space or Enter is pressed.
+func (b *Clickable) Focus() {
+	b.requestFocus = true
+}
+
+// Focused reports whether the element has the input focus.
+func (b *Clickable) Focused() bool {
+	return b.focused
+}
+
 // Clicks returns and clear the clicks since the last call to Clicks.
 func (b *Clickable) Clicks() []Click {
 	clicks := b.clicks
@@ -96,7 +174,19 @@ func (b *Clickable) Layout(gtx layout.Context) layout.Dimensions {
 	stack := op.Save(gtx.Ops)
 	pointer.Rect(image.Rectangle{Max: gtx.Constraints.Min}).Add(gtx.Ops)
 	b.click.Add(gtx.Ops)
+	if gtx.Queue != nil {
+		pointer.CursorNameOp{Name: pointer.CursorPointer}.Add(gtx.Ops)
+	}
+	pointer.InputOp{Tag: &b.longPress, Types: pointer.Drag}.Add(gtx.Ops)
+	key.InputOp{Tag: &b.eventKey, Focusable: b.Focusable}.Add(gtx.Ops)
+	if b.requestFocus {
+		key.FocusOp{Tag: &b.eventKey}.Add(gtx.Ops)
+		b.requestFocus = false
+	}
 	stack.Load()
+	if b.longPress.pending {
+		op.InvalidateOp{At: b.longPress.start.Add(b.longPressDuration())}.Add(gtx.Ops)
+	}
 	for len(b.history) > 0 {
 		c := b.history[0]
 		if c.End.IsZero() || gtx.Now.Sub(c.End) < 1*time.Second {
@@ -108,24 +198,48 @@ func (b *Clickable) Layout(gtx layout.Context) layout.Dimensions {
 	return layout.Dimensions{Size: gtx.Constraints.Min}
 }
 
+func (b *Clickable) longPressDuration() time.Duration {
+	if d := b.LongPressDuration; d > 0 {
+		return d
+	}
+	return defaultLongPressDuration
+}
+
 // update the button state by processing events.
 func (b *Clickable) update(gtx layout.Context) {
 	// Flush clicks from before the last update.
 	n := copy(b.clicks, b.clicks[b.prevClicks:])
 	b.clicks = b.clicks[:n]
 	b.prevClicks = n
+	b.longPress.reported = false
+	b.keys = b.keys[:0]
 
+	b.click.Buttons = b.Buttons
 	for _, e := range b.click.Events(gtx) {
 		switch e.Type {
 		case gesture.TypeClick:
-			b.clicks = append(b.clicks, Click{
-				Modifiers: e.Modifiers,
-				NumClicks: e.NumClicks,
-			})
-			if l := len(b.history); l > 0 {
+			primary := e.Buttons == pointer.ButtonPrimary
+			b.longPress.pending = false
+			d := e.Position.Sub(b.clickPos)
+			slop := float32(gtx.Px(unit.Dp(multiClickSlop)))
+			if e.NumClicks > 1 && d.X*d.X+d.Y*d.Y <= slop*slop {
+				b.numClicks++
+			} else {
+				b.numClicks = 1
+			}
+			b.clickPos = e.Position
+			if !primary || !b.longPress.fired {
+				b.clicks = append(b.clicks, Click{
+					Modifiers: e.Modifiers,
+					Buttons:   e.Buttons,
+					NumClicks: b.numClicks,
+				})
+			}
+			if l := len(b.history); l > 0 && primary {
 				b.history[l-1].End = gtx.Now
 			}
 		case gesture.TypeCancel:
+			b.longPress.pending = false
 			for i := range b.history {
 				b.history[i].Cancelled = true
 				if b.history[i].End.IsZero() {
@@ -133,10 +247,59 @@ func (b *Clickable) update(gtx layout.Context) {
 				}
 			}
 		case gesture.TypePress:
+			if e.Buttons != pointer.ButtonPrimary {
+				break
+			}
 			b.history = append(b.history, Press{
 				Position: e.Position,
 				Start:    gtx.Now,
 			})
+			b.longPress.pending = true
+			b.longPress.fired = false
+			b.longPress.start = gtx.Now
+			b.longPress.pos = e.Position
+		}
+	}
+	for _, e := range gtx.Events(&b.longPress) {
+		e, ok := e.(pointer.Event)
+		if !ok || e.Type != pointer.Drag || !b.longPress.pending {
+			continue
+		}
+		d := e.Position.Sub(b.longPress.pos)
+		slop := float32(gtx.Px(unit.Dp(longPressSlop)))
+		if d.X*d.X+d.Y*d.Y > slop*slop {
+			b.longPress.pending = false
+		}
+	}
+	if b.longPress.pending && !gtx.Now.Before(b.longPress.start.Add(b.longPressDuration())) {
+		b.longPress.pending = false
+		b.longPress.fired = true
+		b.longPress.reported = true
+	}
+	for _, e := range gtx.Events(&b.eventKey) {
+		switch e := e.(type) {
+		case key.FocusEvent:
+			b.focused = e.Focus
+		case key.Event:
+			if !b.focused || e.State != key.Press {
+				break
+			}
+			switch e.Name {
+			case key.NameSpace, key.NameReturn, key.NameEnter:
+				b.clicks = append(b.clicks, Click{
+					Modifiers: e.Modifiers,
+					Buttons:   pointer.ButtonPrimary,
+					NumClicks: 1,
+				})
+				// Keyboard presses have no position; use the center.
+				b.history = append(b.history, Press{
+					Position: layout.FPt(gtx.Constraints.Min).Mul(.5),
+					Start:    gtx.Now,
+					End:      gtx.Now,
+				})
+			default:
+				b.keys = append(b.keys, e)
+			}
 		}
 	}
 }
@@ -0,0 +1,302 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"reflect"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestClickableKeyActivation(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		b   Clickable
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	b.Focus()
+	b.Layout(gtx)
+	r.Frame(&ops)
+	ops.Reset()
+	b.Layout(gtx)
+	if !b.Focused() {
+		t.Fatal("Clickable not focused after Focus")
+	}
+	r.Queue(
+		key.Event{Name: key.NameSpace, State: key.Press},
+		key.Event{Name: key.NameReturn, State: key.Press},
+		key.Event{Name: "A", State: key.Press},
+	)
+	b.Layout(gtx)
+	if got := len(b.Clicks()); got != 2 {
+		t.Errorf("got %d key clicks, expected 2", got)
+	}
+}
+
+func TestClickableFocusable(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		a   Clickable
+		b   = Clickable{Focusable: true}
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	frame := func() {
+		ops.Reset()
+		a.Layout(gtx)
+		b.Layout(gtx)
+		r.Frame(&ops)
+	}
+	frame()
+	for i := 0; i < 2; i++ {
+		r.Queue(key.Event{Name: key.NameTab, State: key.Press})
+		frame()
+		if a.Focused() || !b.Focused() {
+			t.Fatalf("Tab %d: focused %v and %v, expected only the focusable Clickable", i, a.Focused(), b.Focused())
+		}
+	}
+}
+
+func TestClickableLongPress(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		b   Clickable
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Now:         time.Unix(0, 0),
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	b.Layout(gtx)
+	r.Frame(&ops)
+	press := pointer.Event{
+		Type:     pointer.Press,
+		Source:   pointer.Mouse,
+		Buttons:  pointer.ButtonPrimary,
+		Position: f32.Pt(50, 50),
+	}
+	r.Queue(press)
+	b.Layout(gtx)
+	if b.LongPressed() {
+		t.Fatal("long press reported before the press duration")
+	}
+	gtx.Now = gtx.Now.Add(defaultLongPressDuration)
+	b.Layout(gtx)
+	if !b.LongPressed() {
+		t.Fatal("long press not reported")
+	}
+	release := press
+	release.Type = pointer.Release
+	r.Queue(release)
+	b.Layout(gtx)
+	if b.Clicked() {
+		t.Error("long press release reported as a click")
+	}
+
+	// A press that moves beyond the slop is not a long press.
+	r.Queue(press)
+	b.Layout(gtx)
+	drag := press
+	drag.Type = pointer.Move
+	drag.Position = f32.Pt(80, 50)
+	r.Queue(drag)
+	gtx.Now = gtx.Now.Add(defaultLongPressDuration)
+	b.Layout(gtx)
+	if b.LongPressed() {
+		t.Error("long press reported after moving the pointer")
+	}
+	r.Queue(release)
+	b.Layout(gtx)
+	if !b.Clicked() {
+		t.Error("click not reported")
+	}
+}
+
+func TestClickableMultiClick(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		b   Clickable
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	b.Layout(gtx)
+	r.Frame(&ops)
+	click := func(t time.Duration, pos f32.Point) {
+		press := pointer.Event{
+			Type:     pointer.Press,
+			Source:   pointer.Mouse,
+			Buttons:  pointer.ButtonPrimary,
+			Position: pos,
+			Time:     t,
+		}
+		release := press
+		release.Type = pointer.Release
+		r.Queue(press, release)
+	}
+	click(0, f32.Pt(10, 10))
+	click(50*time.Millisecond, f32.Pt(12, 10))
+	click(100*time.Millisecond, f32.Pt(90, 90))
+	b.Layout(gtx)
+	var got []int
+	for _, c := range b.Clicks() {
+		got = append(got, c.NumClicks)
+	}
+	if want := []int{1, 2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got click counts %v, expected %v", got, want)
+	}
+}
+
+func TestClickableButtons(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		b   Clickable
+	)
+	b.Buttons = pointer.ButtonPrimary | pointer.ButtonSecondary
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	b.Layout(gtx)
+	r.Frame(&ops)
+	press := pointer.Event{
+		Type:     pointer.Press,
+		Source:   pointer.Mouse,
+		Buttons:  pointer.ButtonSecondary,
+		Position: f32.Pt(50, 50),
+	}
+	release := press
+	release.Type = pointer.Release
+	release.Buttons = 0
+	r.Queue(press, release)
+	b.Layout(gtx)
+	if len(b.History()) != 0 {
+		t.Error("secondary press recorded in the history")
+	}
+	clicks := b.Clicks()
+	if len(clicks) != 1 || clicks[0].Buttons != pointer.ButtonSecondary {
+		t.Errorf("got clicks %+v, expected a secondary click", clicks)
+	}
+	press.Buttons = pointer.ButtonPrimary
+	r.Queue(press, release)
+	b.Layout(gtx)
+	if len(b.History()) != 1 {
+		t.Error("primary press not recorded in the history")
+	}
+	clicks = b.Clicks()
+	if len(clicks) != 1 || clicks[0].Buttons != pointer.ButtonPrimary {
+		t.Errorf("got clicks %+v, expected a primary click", clicks)
+	}
+}
+
+func TestClickableHover(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		b   Clickable
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	frame := func(layoutButton bool) {
+		ops.Reset()
+		if layoutButton {
+			b.Layout(gtx)
+		}
+		r.Frame(&ops)
+	}
+	move := func(x float32) {
+		r.Queue(pointer.Event{
+			Type:     pointer.Move,
+			Source:   pointer.Mouse,
+			Position: f32.Pt(x, 50),
+		})
+	}
+	frame(true)
+	move(50)
+	frame(true)
+	if !b.Hovered() {
+		t.Fatal("Clickable not hovered")
+	}
+	move(150)
+	frame(true)
+	if b.Hovered() {
+		t.Fatal("Clickable hovered after the pointer left")
+	}
+	move(50)
+	frame(true)
+	// Stop laying out the button while hovered and move the pointer
+	// away.
+	frame(false)
+	move(150)
+	frame(false)
+	// The router cancels the handlers of the Clickable on its
+	// reappearance, which takes effect in the following frame.
+	frame(true)
+	frame(true)
+	if b.Hovered() {
+		t.Error("Clickable hovered after being laid out again")
+	}
+}
+
+func TestClickableCursor(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		b   Clickable
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	b.Layout(gtx)
+	r.Frame(&ops)
+	r.Queue(pointer.Event{
+		Type:     pointer.Move,
+		Source:   pointer.Mouse,
+		Position: f32.Pt(50, 50),
+	})
+	if got := r.Cursor(); got != pointer.CursorPointer {
+		t.Errorf("got cursor %q over Clickable, expected %q", got, pointer.CursorPointer)
+	}
+	// Disabled clickables keep the default cursor.
+	ops.Reset()
+	gtx.Queue = nil
+	b.Layout(gtx)
+	r.Frame(&ops)
+	r.Queue(pointer.Event{
+		Type:     pointer.Move,
+		Source:   pointer.Mouse,
+		Position: f32.Pt(60, 50),
+	})
+	if got := r.Cursor(); got != pointer.CursorDefault {
+		t.Errorf("got cursor %q over disabled Clickable", got)
+	}
+}
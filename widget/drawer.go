@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"math"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/gesture"
+	"gioui.org/internal/fling"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Drawer holds the state of a navigation drawer, a sheet of
+// destinations that slides in from the start edge over a scrim.
+// The drawer is closed by a click on the scrim, by Escape, or by
+// swiping the sheet away.
+type Drawer struct {
+	// Nav is the state of the destinations, and reports the
+	// selected destination.
+	Nav Enum
+	// List is the state of the scrollable list of destinations.
+	List layout.List
+
+	visible bool
+	focus   bool
+	// pos is the position of the sheet, from 0 when hidden to 1
+	// when fully open.
+	pos float32
+	// moving is set while the sheet animates to its resting
+	// position.
+	moving   bool
+	animTime time.Time
+
+	scrim     gesture.Click
+	drag      gesture.Drag
+	estimator fling.Extrapolation
+	// start and startPos are the drag position and sheet position
+	// at the start of a drag.
+	start    float32
+	startPos float32
+	content  int
+}
+
+// Open slides in the drawer.
+func (d *Drawer) Open() {
+	if !d.visible {
+		d.visible = true
+		d.focus = true
+	}
+}
+
+// Close slides out the drawer.
+func (d *Drawer) Close() {
+	d.visible = false
+}
+
+// Visible reports whether the drawer is open.
+func (d *Drawer) Visible() bool {
+	return d.visible
+}
+
+// Position returns the position of the sheet, from 0 when hidden to
+// 1 when fully open. It is useful for fading in the scrim.
+func (d *Drawer) Position() float32 {
+	return d.pos
+}
+
+// Dragging reports whether the sheet is being dragged.
+func (d *Drawer) Dragging() bool {
+	return d.drag.Dragging()
+}
+
+// Layout lays out scrim over the minimum constraints and sheet at the
+// start edge, displaced by its position. While the sheet is shown,
+// the drawer intercepts pointer input over the whole area and takes
+// the keyboard focus.
+func (d *Drawer) Layout(gtx layout.Context, scrim, sheet layout.Widget) layout.Dimensions {
+	size := gtx.Constraints.Min
+	macro := op.Record(gtx.Ops)
+	sgtx := gtx
+	sgtx.Constraints = layout.Constraints{Min: image.Pt(0, size.Y), Max: size}
+	dims := sheet(sgtx)
+	call := macro.Stop()
+	d.update(gtx, float32(dims.Size.X))
+	if !d.visible && d.pos == 0 {
+		return layout.Dimensions{Size: size}
+	}
+
+	defer op.Save(gtx.Ops).Load()
+	stack := op.Save(gtx.Ops)
+	cgtx := gtx
+	cgtx.Constraints = layout.Exact(size)
+	scrim(cgtx)
+	stack.Load()
+	pointer.Rect(image.Rectangle{Max: size}).Add(gtx.Ops)
+	d.drag.Add(gtx.Ops)
+	key.InputOp{Tag: d}.Add(gtx.Ops)
+	if d.focus {
+		key.FocusOp{Tag: d}.Add(gtx.Ops)
+		d.focus = false
+	}
+	stack = op.Save(gtx.Ops)
+	// The scrim handler hides the area beneath from pointer input.
+	d.scrim.Add(gtx.Ops)
+	stack.Load()
+	off := math.Round(float64(-(1 - d.pos) * float32(dims.Size.X)))
+	op.Offset(f32.Pt(float32(off), 0)).Add(gtx.Ops)
+	stack = op.Save(gtx.Ops)
+	// Block presses on the sheet from reaching the scrim.
+	pointer.Rect(image.Rectangle{Max: dims.Size}).Add(gtx.Ops)
+	pointer.InputOp{Tag: &d.content, Types: pointer.Press}.Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	stack.Load()
+	return layout.Dimensions{Size: size}
+}
+
+func (d *Drawer) update(gtx layout.Context, width float32) {
+	for _, e := range d.scrim.Events(gtx) {
+		if e.Type == gesture.TypeClick {
+			d.Close()
+		}
+	}
+	for _, e := range gtx.Events(d) {
+		if e, ok := e.(key.Event); ok && e.Name == key.NameEscape && e.State == key.Press {
+			d.Close()
+		}
+	}
+	for _, e := range d.drag.Events(gtx.Metric, gtx, gesture.Horizontal) {
+		if width <= 0 {
+			continue
+		}
+		switch e.Type {
+		case pointer.Press:
+			d.start = e.Position.X
+			d.startPos = d.pos
+			d.estimator = fling.Extrapolation{}
+			d.estimator.Sample(e.Time, d.pos*width)
+		case pointer.Drag:
+			d.pos = d.startPos + (e.Position.X-d.start)/width
+			if d.pos < 0 {
+				d.pos = 0
+			} else if d.pos > 1 {
+				d.pos = 1
+			}
+			d.estimator.Sample(e.Time, d.pos*width)
+		case pointer.Release:
+			v := d.estimator.Estimate().Velocity
+			if d.pos+v*float32(swipeProjection.Seconds())/width < .5 {
+				d.Close()
+			}
+		}
+	}
+	if d.Dragging() {
+		d.moving = false
+		return
+	}
+	var target float32
+	if d.visible {
+		target = 1
+	}
+	if d.pos == target {
+		d.moving = false
+		return
+	}
+	if !d.moving {
+		d.moving = true
+		d.animTime = gtx.Now
+	}
+	dt := gtx.Now.Sub(d.animTime).Seconds()
+	d.animTime = gtx.Now
+	d.pos = target + (d.pos-target)*float32(math.Exp(-swipeDecay*dt))
+	if diff := (d.pos - target) * width; -.5 < diff && diff < .5 {
+		d.pos = target
+	}
+	op.InvalidateOp{}.Add(gtx.Ops)
+}
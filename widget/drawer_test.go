@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestDrawer(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		d   Drawer
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Now:         time.Unix(0, 0),
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	scrim := func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: gtx.Constraints.Min}
+	}
+	sheet := func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: image.Pt(60, gtx.Constraints.Min.Y)}
+	}
+	frame := func(e ...event.Event) {
+		r.Frame(&ops)
+		r.Queue(e...)
+		ops.Reset()
+		d.Layout(gtx, scrim, sheet)
+	}
+	// settle advances the time until the sheet rests.
+	settle := func() {
+		for i := 0; i < 20; i++ {
+			gtx.Now = gtx.Now.Add(50 * time.Millisecond)
+			frame()
+		}
+	}
+	touch := func(typ pointer.Type, x float32, t time.Duration) pointer.Event {
+		return pointer.Event{
+			Type:     typ,
+			Source:   pointer.Touch,
+			Position: f32.Pt(x, 50),
+			Time:     t,
+		}
+	}
+	click := func(x float32) []event.Event {
+		return []event.Event{
+			touch(pointer.Press, x, 0),
+			touch(pointer.Release, x, 0),
+		}
+	}
+	open := func() {
+		d.Open()
+		frame()
+		settle()
+		if got := d.Position(); got != 1 {
+			t.Fatalf("got position %v after opening, expected 1", got)
+		}
+	}
+
+	open()
+	frame(click(30)...)
+	frame()
+	if !d.Visible() {
+		t.Error("drawer closed by a click on the sheet")
+	}
+	frame(click(80)...)
+	frame()
+	if d.Visible() {
+		t.Error("drawer open after a click on the scrim")
+	}
+	settle()
+	if got := d.Position(); got != 0 {
+		t.Errorf("got position %v after closing, expected 0", got)
+	}
+
+	open()
+	frame(key.Event{Name: key.NameEscape, State: key.Press})
+	frame()
+	if d.Visible() {
+		t.Error("drawer open after Escape")
+	}
+
+	open()
+	frame(
+		touch(pointer.Press, 50, 0),
+		touch(pointer.Move, 40, time.Second),
+		touch(pointer.Move, 30, 2*time.Second),
+	)
+	if got := d.Position() * 60; got < 39.5 || got > 40.5 {
+		t.Errorf("got visible sheet width %v while dragging, expected 40", got)
+	}
+	frame(touch(pointer.Release, 30, 3*time.Second))
+	frame()
+	if !d.Visible() {
+		t.Error("drawer closed by a short swipe")
+	}
+	settle()
+	frame(
+		touch(pointer.Press, 50, 0),
+		touch(pointer.Move, 10, time.Second),
+		touch(pointer.Release, 10, 2*time.Second),
+	)
+	frame()
+	if d.Visible() {
+		t.Error("drawer open after a swipe")
+	}
+}
@@ -45,6 +45,21 @@ type Editor struct {
 	// Newline characters are not masked. When non-zero, the unmasked contents
 	// are accessed by Len, Text, and SetText.
 	Mask rune
+	// InputHint specifies the type of on-screen keyboard to be displayed.
+	InputHint key.InputHint
+	// MaxLen limits the editor content to a maximum number of runes.
+	// Zero means no limit.
+	MaxLen int
+	// Filter is the list of runes allowed in the Editor. If Filter
+	// is empty, all runes are allowed.
+	Filter string
+	// BlinkInterval is the duration of a caret blink cycle. If zero,
+	// a default of one second is used. A negative BlinkInterval shows
+	// a steady caret.
+	BlinkInterval time.Duration
+	// UndoLimit is the maximum number of changes kept for Undo.
+	// If zero, a default of 100 is used.
+	UndoLimit int
 
 	eventKey     int
 	font         text.Font
@@ -52,16 +67,19 @@ type Editor struct {
 	textSize     fixed.Int26_6
 	blinkStart   time.Time
 	focused      bool
+	focusTime    time.Time
 	rr           editBuffer
 	maskReader   maskReader
 	lastMask     rune
 	maxWidth     int
+	caretWidth   fixed.Int26_6
 	viewSize     image.Point
 	valid        bool
 	lines        []text.Line
 	shapes       []line
 	dims         layout.Dimensions
 	requestFocus bool
+	history      undoHistory
 
 	caret struct {
 		on     bool
@@ -185,7 +203,9 @@ type line struct {
 }
 
 const (
-	blinksPerSecond  = 1
+	defaultBlinkInterval = time.Second
+	// maxBlinkDuration is the duration after the last input the
+	// caret stops blinking.
 	maxBlinkDuration = 10 * time.Second
 )
 
@@ -253,6 +273,7 @@ func (e *Editor) processPointer(gtx layout.Context) {
 				evt.Type == gesture.TypeClick:
 				prevCaretPos := e.caret.start
 				e.blinkStart = gtx.Now
+				e.history.typing = false
 				e.moveCoord(image.Point{
 					X: int(math.Round(float64(evt.Position.X))),
 					Y: int(math.Round(float64(evt.Position.Y))),
@@ -327,6 +348,9 @@ func (e *Editor) processKey(gtx layout.Context) {
 		e.blinkStart = gtx.Now
 		switch ke := ke.(type) {
 		case key.FocusEvent:
+			if e.focused != ke.Focus {
+				e.focusTime = gtx.Now
+			}
 			e.focused = ke.Focus
 		case key.Event:
 			if !e.focused || ke.State != key.Press {
@@ -334,25 +358,34 @@ func (e *Editor) processKey(gtx layout.Context) {
 			}
 			if e.Submit && (ke.Name == key.NameReturn || ke.Name == key.NameEnter) {
 				if !ke.Modifiers.Contain(key.ModShift) {
+					e.history.typing = false
 					e.events = append(e.events, SubmitEvent{
 						Text: e.Text(),
 					})
 					continue
 				}
 			}
+			// Handled keys end a group of typed runes. Other keys,
+			// such as those preceding EditEvents, don't.
+			typing := e.history.typing
+			e.history.typing = false
 			if e.command(gtx, ke) {
 				e.caret.scroll = true
 				e.scroller.Stop()
+			} else {
+				e.history.typing = typing
 			}
 		case key.EditEvent:
 			e.caret.scroll = true
 			e.scroller.Stop()
-			e.append(ke.Text)
+			e.typeText(ke.Text)
 		// Complete a paste event, initiated by Shortcut-V in Editor.command().
 		case clipboard.Event:
 			e.caret.scroll = true
 			e.scroller.Stop()
-			e.append(ke.Text)
+			e.history.typing = false
+			// Normalize line endings from platforms that use CRLF.
+			e.append(strings.ReplaceAll(ke.Text, "\r\n", "\n"))
 		}
 		if e.rr.Changed() {
 			e.events = append(e.events, ChangeEvent{})
@@ -378,6 +411,13 @@ func (e *Editor) command(gtx layout.Context, k key.Event) bool {
 	switch k.Name {
 	case key.NameReturn, key.NameEnter:
 		e.append("\n")
+	case key.NameTab:
+		// Only multi-line editors receive plain Tab presses; Shift-Tab
+		// moves the focus.
+		if e.SingleLine || k.Modifiers != 0 {
+			return false
+		}
+		e.append("\t")
 	case key.NameDeleteBackward:
 		if moveByWord {
 			e.deleteWord(-1)
@@ -438,6 +478,20 @@ func (e *Editor) command(gtx layout.Context, k key.Event) bool {
 				e.Delete(1)
 			}
 		}
+	case "Z":
+		switch k.Modifiers {
+		case key.ModShortcut:
+			e.Undo()
+		case key.ModShortcut | key.ModShift:
+			e.Redo()
+		default:
+			return false
+		}
+	case "Y":
+		if k.Modifiers != key.ModShortcut {
+			return false
+		}
+		e.Redo()
 	// Select all
 	case "A":
 		if k.Modifiers != key.ModShortcut {
@@ -460,6 +514,12 @@ func (e *Editor) Focused() bool {
 	return e.focused
 }
 
+// FocusTime returns the time of the most recent change of focus.
+// It is useful for animating the transition between focus states.
+func (e *Editor) FocusTime() time.Time {
+	return e.focusTime
+}
+
 // Layout lays out the editor.
 func (e *Editor) Layout(gtx layout.Context, sh text.Shaper, font text.Font, size unit.Value) layout.Dimensions {
 	textSize := fixed.I(gtx.Px(size))
@@ -484,6 +544,7 @@ func (e *Editor) Layout(gtx layout.Context, sh text.Shaper, font text.Font, size
 		e.lastMask = e.Mask
 		e.invalidate()
 	}
+	e.caretWidth = fixed.I(gtx.Px(unit.Dp(1)))
 
 	e.makeValid()
 	e.processEvents(gtx)
@@ -511,8 +572,7 @@ func (e *Editor) layout(gtx layout.Context) layout.Dimensions {
 		X: -e.scrollOff.X,
 		Y: -e.scrollOff.Y,
 	}
-	clip := textPadding(e.lines)
-	clip.Max = clip.Max.Add(e.viewSize)
+	clip := e.textClip()
 	startSel, endSel := sortPoints(e.caret.start.lineCol, e.caret.end.lineCol)
 	it := segmentIterator{
 		startSel:  startSel,
@@ -533,7 +593,8 @@ func (e *Editor) layout(gtx layout.Context) layout.Dimensions {
 		e.shapes = append(e.shapes, line{off, path, selected, yOffs, size})
 	}
 
-	key.InputOp{Tag: &e.eventKey}.Add(gtx.Ops)
+	// Multi-line editors insert Tab presses as tabs.
+	key.InputOp{Tag: &e.eventKey, Hint: e.InputHint, Focusable: true, AcceptsTab: !e.SingleLine}.Add(gtx.Ops)
 	if e.requestFocus {
 		key.FocusOp{Tag: &e.eventKey}.Add(gtx.Ops)
 		key.SoftKeyboardOp{Show: true}.Add(gtx.Ops)
@@ -544,7 +605,7 @@ func (e *Editor) layout(gtx layout.Context) layout.Dimensions {
 	r.Min.X -= pointerPadding
 	r.Min.Y -= pointerPadding
 	r.Max.X += pointerPadding
-	r.Max.X += pointerPadding
+	r.Max.Y += pointerPadding
 	pointer.Rect(r).Add(gtx.Ops)
 	pointer.CursorNameOp{Name: pointer.CursorText}.Add(gtx.Ops)
 
@@ -564,24 +625,27 @@ func (e *Editor) layout(gtx layout.Context) layout.Dimensions {
 	if e.focused {
 		now := gtx.Now
 		dt := now.Sub(e.blinkStart)
-		blinking := dt < maxBlinkDuration
-		const timePerBlink = time.Second / blinksPerSecond
-		nextBlink := now.Add(timePerBlink/2 - dt%(timePerBlink/2))
+		timePerBlink := e.BlinkInterval
+		if timePerBlink == 0 {
+			timePerBlink = defaultBlinkInterval
+		}
+		blinking := timePerBlink > 0 && dt < maxBlinkDuration
 		if blinking {
+			nextBlink := now.Add(timePerBlink/2 - dt%(timePerBlink/2))
 			redraw := op.InvalidateOp{At: nextBlink}
 			redraw.Add(gtx.Ops)
 		}
-		e.caret.on = e.focused && (!blinking || dt%timePerBlink < timePerBlink/2)
+		e.caret.on = !blinking || dt%timePerBlink < timePerBlink/2
 	}
 
-	return layout.Dimensions{Size: e.viewSize, Baseline: e.dims.Baseline}
+	// The baseline of the first line, as scrolled in the view.
+	top := e.dims.Size.Y - e.dims.Baseline - e.scrollOff.Y
+	return layout.Dimensions{Size: e.viewSize, Baseline: e.viewSize.Y - top}
 }
 
 // PaintSelection paints the contrasting background for selected text.
 func (e *Editor) PaintSelection(gtx layout.Context) {
-	cl := textPadding(e.lines)
-	cl.Max = cl.Max.Add(e.viewSize)
-	clip.Rect(cl).Add(gtx.Ops)
+	clip.Rect(e.textClip()).Add(gtx.Ops)
 	for _, shape := range e.shapes {
 		if !shape.selected {
 			continue
@@ -597,9 +661,7 @@ func (e *Editor) PaintSelection(gtx layout.Context) {
 }
 
 func (e *Editor) PaintText(gtx layout.Context) {
-	cl := textPadding(e.lines)
-	cl.Max = cl.Max.Add(e.viewSize)
-	clip.Rect(cl).Add(gtx.Ops)
+	clip.Rect(e.textClip()).Add(gtx.Ops)
 	for _, shape := range e.shapes {
 		stack := op.Save(gtx.Ops)
 		op.Offset(layout.FPt(shape.offset)).Add(gtx.Ops)
@@ -614,7 +676,7 @@ func (e *Editor) PaintCaret(gtx layout.Context) {
 		return
 	}
 	e.makeValid()
-	carWidth := fixed.I(gtx.Px(unit.Dp(1)))
+	carWidth := e.caretWidth
 	carX := e.caret.start.x
 	carY := e.caret.start.y
 
@@ -629,16 +691,17 @@ func (e *Editor) PaintCaret(gtx layout.Context) {
 		X: -e.scrollOff.X,
 		Y: -e.scrollOff.Y,
 	})
-	cl := textPadding(e.lines)
-	// Account for caret width to each side.
+	cl := e.textClip()
+	// Account for caret width to each side, unless the text is
+	// scrolled out of view there.
+	left, right := e.scrolledOut()
 	whalf := (carWidth / 2).Ceil()
-	if cl.Max.X < whalf {
-		cl.Max.X = whalf
+	if !right && cl.Max.X < e.viewSize.X+whalf {
+		cl.Max.X = e.viewSize.X + whalf
 	}
-	if cl.Min.X > -whalf {
+	if !left && cl.Min.X > -whalf {
 		cl.Min.X = -whalf
 	}
-	cl.Max = cl.Max.Add(e.viewSize)
 	carRect = cl.Intersect(carRect)
 	if !carRect.Empty() {
 		st := op.Save(gtx.Ops)
@@ -664,6 +727,32 @@ func (e *Editor) SetText(s string) {
 	e.caret.start = combinedPos{}
 	e.caret.end = combinedPos{}
 	e.prepend(s)
+	e.clearHistory()
+}
+
+// textClip returns the area of the visible text, relative to the
+// view. A single line editor hides the text scrolled out of view.
+func (e *Editor) textClip() image.Rectangle {
+	cl := textPadding(e.lines)
+	left, right := e.scrolledOut()
+	if left {
+		cl.Min.X = 0
+	}
+	if right {
+		cl.Max.X = 0
+	}
+	cl.Max = cl.Max.Add(e.viewSize)
+	return cl
+}
+
+// scrolledOut reports whether the text of a single line editor
+// extends beyond the left and right edges of the view.
+func (e *Editor) scrolledOut() (left, right bool) {
+	if !e.SingleLine {
+		return false, false
+	}
+	b := e.scrollBounds()
+	return e.scrollOff.X > b.Min.X, e.scrollOff.X < b.Max.X
 }
 
 func (e *Editor) scrollBounds() image.Rectangle {
@@ -676,6 +765,11 @@ func (e *Editor) scrollBounds() image.Rectangle {
 			}
 		}
 		b.Max.X = e.dims.Size.X + b.Min.X - e.viewSize.X
+		if b.Max.X > b.Min.X {
+			// Leave room for the caret at the end of overflowing
+			// text.
+			b.Max.X += e.caretWidth.Ceil()
+		}
 	} else {
 		b.Max.Y = e.dims.Size.Y - e.viewSize.Y
 	}
@@ -835,12 +929,14 @@ func (e *Editor) Delete(runes int) {
 		return
 	}
 
+	e.beginBatch()
+	defer e.endBatch()
 	if l := e.caret.end.ofs - e.caret.start.ofs; l != 0 {
-		e.caret.start.ofs = e.rr.deleteRunes(e.caret.start.ofs, l)
+		e.caret.start.ofs = e.deleteRunes(e.caret.start.ofs, l)
 		runes -= sign(runes)
 	}
 
-	e.caret.start.ofs = e.rr.deleteRunes(e.caret.start.ofs, runes)
+	e.caret.start.ofs = e.deleteRunes(e.caret.start.ofs, runes)
 	e.caret.start.xoff = 0
 	e.ClearSelection()
 	e.invalidate()
@@ -857,22 +953,54 @@ func (e *Editor) Insert(s string) {
 // there is a selection, append overwrites it.
 // xxx|yyy + append zzz => xxxzzz|yyy
 func (e *Editor) append(s string) {
-	e.prepend(s)
-	e.caret.start.ofs += len(s)
+	e.caret.start.ofs += e.prepend(s)
 	e.caret.end.ofs = e.caret.start.ofs
 }
 
 // prepend inserts s after the cursor; the caret does not change. If there is
-// a selection, prepend overwrites it.
+// a selection, prepend overwrites it. prepend returns the number of bytes
+// inserted, after applying SingleLine, Filter and MaxLen.
 // xxx|yyy + prepend zzz => xxx|zzzyyy
-func (e *Editor) prepend(s string) {
+func (e *Editor) prepend(s string) int {
 	if e.SingleLine {
 		s = strings.ReplaceAll(s, "\n", " ")
 	}
-	e.caret.start.ofs = e.rr.deleteRunes(e.caret.start.ofs, e.caret.end.ofs-e.caret.start.ofs) // Delete any selection first.
-	e.rr.prepend(e.caret.start.ofs, s)
+	s = e.limit(s)
+	e.beginBatch()
+	defer e.endBatch()
+	e.caret.start.ofs = e.deleteRunes(e.caret.start.ofs, e.caret.end.ofs-e.caret.start.ofs) // Delete any selection first.
+	e.prependText(e.caret.start.ofs, s)
 	e.caret.start.xoff = 0
 	e.invalidate()
+	return len(s)
+}
+
+// limit removes the runes of s not in Filter, and truncates s to fit
+// MaxLen when replacing the selection.
+func (e *Editor) limit(s string) string {
+	if e.Filter != "" {
+		s = strings.Map(func(r rune) rune {
+			if !strings.ContainsRune(e.Filter, r) {
+				return -1
+			}
+			return r
+		}, s)
+	}
+	if e.MaxLen <= 0 {
+		return s
+	}
+	n := utf8.RuneCountInString(e.rr.String()) - utf8.RuneCountInString(e.SelectedText())
+	avail := e.MaxLen - n
+	if avail <= 0 {
+		return ""
+	}
+	for i := range s {
+		if avail == 0 {
+			return s[:i]
+		}
+		avail--
+	}
+	return s
 }
 
 func (e *Editor) movePages(pages int, selAct selectionAction) {
@@ -1101,6 +1229,8 @@ func (e *Editor) deleteWord(distance int) {
 
 	e.makeValid()
 
+	e.beginBatch()
+	defer e.endBatch()
 	if e.caret.start.ofs != e.caret.end.ofs {
 		e.Delete(1)
 		distance -= sign(distance)
@@ -1157,7 +1287,7 @@ func (e *Editor) scrollToCaret() {
 		var dist int
 		if d := e.caret.start.x.Floor() - e.scrollOff.X; d < 0 {
 			dist = d
-		} else if d := e.caret.start.x.Ceil() - (e.scrollOff.X + e.viewSize.X); d > 0 {
+		} else if d := (e.caret.start.x + e.caretWidth).Ceil() - (e.scrollOff.X + e.viewSize.X); d > 0 {
 			dist = d
 		}
 		e.scrollRel(dist, 0)
@@ -12,13 +12,16 @@ import (
 	"strings"
 	"testing"
 	"testing/quick"
+	"time"
 	"unicode"
 
 	"gioui.org/f32"
 	"gioui.org/font/gofont"
+	"gioui.org/io/clipboard"
 	"gioui.org/io/event"
 	"gioui.org/io/key"
 	"gioui.org/io/pointer"
+	"gioui.org/io/router"
 	"gioui.org/layout"
 	"gioui.org/op"
 	"gioui.org/text"
@@ -105,6 +108,278 @@ func TestEditorDimensions(t *testing.T) {
 	}
 }
 
+func TestEditorBaseline(t *testing.T) {
+	e := new(Editor)
+	e.SetText("text")
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(100, 100)},
+	}
+	cache := text.NewCache(gofont.Collection())
+	fontSize := unit.Px(10)
+	font := text.Font{}
+	dims := e.Layout(gtx, cache, font, fontSize)
+	label := Label{}.Layout(gtx, cache, font, fontSize, "text")
+	if dims.Baseline != label.Baseline {
+		t.Errorf("got editor baseline %d, want the label baseline %d", dims.Baseline, label.Baseline)
+	}
+	gtx.Constraints.Min.Y = 50
+	dims = e.Layout(gtx, cache, font, fontSize)
+	if got, want := dims.Size.Y-dims.Baseline, label.Size.Y-label.Baseline; got != want {
+		t.Errorf("got baseline %d from the top of a taller editor, want %d", got, want)
+	}
+}
+
+func TestEditorPaste(t *testing.T) {
+	cache := text.NewCache(gofont.Collection())
+	for _, singleLine := range []bool{false, true} {
+		e := &Editor{SingleLine: singleLine}
+		e.SetText("ab")
+		e.SetCaret(1, 1)
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Constraints: layout.Exact(image.Pt(100, 100)),
+			Queue:       newQueue(clipboard.Event{Text: "1\r\n2\n3"}),
+		}
+		e.Focus()
+		e.Layout(gtx, cache, text.Font{}, unit.Px(10))
+		want := "a1\n2\n3b"
+		if singleLine {
+			want = "a1 2 3b"
+		}
+		if got := e.Text(); got != want {
+			t.Errorf("SingleLine: %v: pasted text %q, expected %q", singleLine, got, want)
+		}
+	}
+}
+
+func TestEditorUndo(t *testing.T) {
+	e := new(Editor)
+	for _, r := range "hello world" {
+		e.typeText(string(r))
+	}
+	for _, want := range []string{"hello", ""} {
+		e.Undo()
+		if got := e.Text(); got != want {
+			t.Errorf("undo: got %q, expected %q", got, want)
+		}
+	}
+	for _, want := range []string{"hello", "hello world"} {
+		e.Redo()
+		if got := e.Text(); got != want {
+			t.Errorf("redo: got %q, expected %q", got, want)
+		}
+	}
+	// Replace a selection, then undo the replacement in one step.
+	e.SetCaret(0, len("hello"))
+	e.typeText("j")
+	if got, want := e.Text(), "j world"; got != want {
+		t.Fatalf("got %q, expected %q", got, want)
+	}
+	e.Undo()
+	if got, want := e.Text(), "hello world"; got != want {
+		t.Errorf("undo replacement: got %q, expected %q", got, want)
+	}
+	if got, want := e.SelectedText(), "hello"; got != want {
+		t.Errorf("undo selected %q, expected %q", got, want)
+	}
+	e.Redo()
+	e.Insert("!")
+	e.Redo()
+	if got, want := e.Text(), "j! world"; got != want {
+		t.Errorf("redo after change: got %q, expected %q", got, want)
+	}
+
+	e = &Editor{UndoLimit: 2}
+	e.Insert("a")
+	e.Insert("b")
+	e.Insert("c")
+	e.Undo()
+	e.Undo()
+	e.Undo()
+	if got, want := e.Text(), "a"; got != want {
+		t.Errorf("undo beyond limit: got %q, expected %q", got, want)
+	}
+}
+
+func TestEditorUndoKeyEvents(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		e   Editor
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	cache := text.NewCache(gofont.Collection())
+	frame := func() {
+		ops.Reset()
+		e.Layout(gtx, cache, text.Font{}, unit.Px(10))
+		r.Frame(&ops)
+	}
+	e.Focus()
+	frame()
+	frame()
+	// Backends precede each EditEvent with a key.Event.
+	for _, c := range "hello world" {
+		s := string(c)
+		name := strings.ToUpper(s)
+		if c == ' ' {
+			name = key.NameSpace
+		}
+		r.Queue(
+			key.Event{Name: name, State: key.Press},
+			key.EditEvent{Text: s},
+		)
+		frame()
+	}
+	if got, want := e.Text(), "hello world"; got != want {
+		t.Fatalf("got %q, expected %q", got, want)
+	}
+	for _, want := range []string{"hello", ""} {
+		e.Undo()
+		if got := e.Text(); got != want {
+			t.Errorf("undo: got %q, expected %q", got, want)
+		}
+	}
+}
+
+func TestEditorTab(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		e   Editor
+		b   = Clickable{Focusable: true}
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	cache := text.NewCache(gofont.Collection())
+	frame := func() {
+		ops.Reset()
+		e.Layout(gtx, cache, text.Font{}, unit.Px(10))
+		b.Layout(gtx)
+		r.Frame(&ops)
+	}
+	e.Focus()
+	frame()
+	frame()
+	r.Queue(key.Event{Name: key.NameTab, State: key.Press})
+	frame()
+	if got, want := e.Text(), "\t"; got != want {
+		t.Errorf("Tab: got %q, expected %q", got, want)
+	}
+	r.Queue(key.Event{Name: key.NameTab, Modifiers: key.ModShift, State: key.Press})
+	frame()
+	frame()
+	if e.Focused() {
+		t.Error("Shift-Tab didn't move the focus out of the editor")
+	}
+	if got, want := e.Text(), "\t"; got != want {
+		t.Errorf("Shift-Tab: got %q, expected %q", got, want)
+	}
+}
+
+func TestEditorLimit(t *testing.T) {
+	e := &Editor{MaxLen: 4, Filter: "0123456789"}
+	e.Insert("1a2")
+	if got, want := e.Text(), "12"; got != want {
+		t.Errorf("filtered insert: got %q, expected %q", got, want)
+	}
+	e.Insert("3456")
+	if got, want := e.Text(), "1234"; got != want {
+		t.Errorf("insert beyond MaxLen: got %q, expected %q", got, want)
+	}
+	// Replacing a selection frees its runes.
+	e.SetCaret(0, 2)
+	e.Insert("789")
+	if got, want := e.Text(), "7834"; got != want {
+		t.Errorf("replace selection: got %q, expected %q", got, want)
+	}
+}
+
+func TestEditorBlink(t *testing.T) {
+	cache := text.NewCache(gofont.Collection())
+	start := time.Unix(0, 0)
+	for _, interval := range []time.Duration{-1, 0, 500 * time.Millisecond} {
+		e := &Editor{BlinkInterval: interval}
+		gtx := layout.Context{
+			Ops:         new(op.Ops),
+			Now:         start,
+			Constraints: layout.Exact(image.Pt(100, 100)),
+			Queue:       newQueue(key.FocusEvent{Focus: true}),
+		}
+		e.Layout(gtx, cache, text.Font{}, unit.Px(10))
+		gtx.Queue = nil
+		for _, dt := range []time.Duration{0, 250 * time.Millisecond, 750 * time.Millisecond} {
+			gtx.Now = start.Add(dt)
+			e.Layout(gtx, cache, text.Font{}, unit.Px(10))
+			blink := interval
+			if blink == 0 {
+				blink = defaultBlinkInterval
+			}
+			want := blink < 0 || dt%blink < blink/2
+			if e.caret.on != want {
+				t.Errorf("BlinkInterval %v: caret visible %v after %v, expected %v", interval, e.caret.on, dt, want)
+			}
+		}
+	}
+}
+
+func TestEditorSingleLineScroll(t *testing.T) {
+	cache := text.NewCache(gofont.Collection())
+	e := &Editor{SingleLine: true}
+	e.SetText(strings.Repeat("scroll ", 10))
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Exact(image.Pt(100, 20)),
+	}
+	layoutWith := func(events ...event.Event) {
+		gtx.Ops.Reset()
+		gtx.Queue = newQueue(events...)
+		e.Layout(gtx, cache, text.Font{}, unit.Px(10))
+	}
+	assertVisible := func(name string) {
+		t.Helper()
+		x := e.caret.start.x.Round() - e.scrollOff.X
+		if x < 0 || x+e.caretWidth.Ceil() > e.viewSize.X {
+			t.Errorf("%s: caret at %d outside the view of width %d", name, x, e.viewSize.X)
+		}
+	}
+	layoutWith(key.FocusEvent{Focus: true})
+	if e.scrollOff.X != 0 {
+		t.Errorf("got scroll offset %d before moving the caret", e.scrollOff.X)
+	}
+	layoutWith(key.Event{Name: key.NameEnd, State: key.Press})
+	assertVisible("End")
+	if e.scrollOff.X == 0 {
+		t.Error("editor didn't scroll to the end of the text")
+	}
+	// Clipping hides the text scrolled out of view to the left.
+	if cl := e.textClip(); cl.Min.X != 0 || cl.Max.X < e.viewSize.X {
+		t.Errorf("got text clip %v for view %v", cl, e.viewSize)
+	}
+	layoutWith(pointer.Event{
+		Type:     pointer.Press,
+		Source:   pointer.Mouse,
+		Buttons:  pointer.ButtonPrimary,
+		Position: f32.Pt(5, 5),
+	})
+	if _, col := e.CaretPos(); col == 0 || col == e.Len() {
+		t.Errorf("click moved the caret to column %d, expected within the text", col)
+	}
+	assertVisible("click")
+	layoutWith(key.Event{Name: key.NameHome, State: key.Press})
+	assertVisible("Home")
+	if e.scrollOff.X != 0 {
+		t.Errorf("got scroll offset %d at the start of the text", e.scrollOff.X)
+	}
+}
+
 // assertCaret asserts that the editor caret is at a particular line
 // and column, and that the byte position matches as well.
 func assertCaret(t *testing.T, e *Editor, line, col, bytes int) {
@@ -1,10 +1,9 @@
 package widget
 
 import (
-	"image"
+	"time"
 
-	"gioui.org/gesture"
-	"gioui.org/io/pointer"
+	"gioui.org/io/key"
 	"gioui.org/layout"
 	"gioui.org/op"
 )
@@ -14,9 +13,11 @@ type Enum struct {
 	hovered  string
 	hovering bool
 
-	changed bool
+	changed    bool
+	changeTime time.Time
+	prevValue  string
 
-	clicks []gesture.Click
+	clicks []*Clickable
 	values []string
 }
 
@@ -37,41 +38,96 @@ func (e *Enum) Changed() bool {
 	return changed
 }
 
+// ChangeTime returns the time of the most recent change of Value by
+// user interaction. It is useful for animating the transition
+// between values.
+func (e *Enum) ChangeTime() time.Time {
+	return e.changeTime
+}
+
+// Previous returns the Value replaced by the most recent change by
+// user interaction.
+func (e *Enum) Previous() string {
+	return e.prevValue
+}
+
 // Hovered returns the key that is highlighted, or false if none are.
 func (e *Enum) Hovered() (string, bool) {
 	return e.hovered, e.hovering
 }
 
-// Layout adds the event handler for key.
-func (e *Enum) Layout(gtx layout.Context, key string) layout.Dimensions {
-	defer op.Save(gtx.Ops).Load()
-	pointer.Rect(image.Rectangle{Max: gtx.Constraints.Min}).Add(gtx.Ops)
+// Focused returns the key that has the keyboard focus, or false if
+// none do.
+func (e *Enum) Focused() (string, bool) {
+	for i, c := range e.clicks {
+		if c.Focused() {
+			return e.values[i], true
+		}
+	}
+	return "", false
+}
+
+// History is the past pointer presses of key useful for drawing
+// markers.
+func (e *Enum) History(key string) []Press {
+	if idx := index(e.values, key); idx != -1 {
+		return e.clicks[idx].History()
+	}
+	return nil
+}
 
-	if index(e.values, key) == -1 {
+// Layout adds the event handler for key. The arrow keys select and
+// focus the previous or next key of a focused element.
+func (e *Enum) Layout(gtx layout.Context, key string) layout.Dimensions {
+	idx := index(e.values, key)
+	if idx == -1 {
 		e.values = append(e.values, key)
-		e.clicks = append(e.clicks, gesture.Click{})
-		e.clicks[len(e.clicks)-1].Add(gtx.Ops)
-	} else {
-		idx := index(e.values, key)
-		clk := &e.clicks[idx]
-		for _, ev := range clk.Events(gtx) {
-			switch ev.Type {
-			case gesture.TypeClick:
-				if new := e.values[idx]; new != e.Value {
-					e.Value = new
-					e.changed = true
-				}
-			}
-		}
-		if e.hovering && e.hovered == key {
-			e.hovering = false
-		}
-		if clk.Hovered() {
-			e.hovered = key
-			e.hovering = true
+		e.clicks = append(e.clicks, new(Clickable))
+		idx = len(e.clicks) - 1
+	}
+	clk := e.clicks[idx]
+	// Tab stops at the selected key only, or the first key if none
+	// is selected. The arrow keys move between the keys.
+	clk.Focusable = key == e.Value || idx == 0 && index(e.values, e.Value) == -1
+	dims := clk.Layout(gtx)
+	for clk.Clicked() {
+		e.selectIndex(gtx, idx)
+	}
+	for _, k := range clk.keys {
+		if next := e.arrow(idx, k); next != idx {
+			e.selectIndex(gtx, next)
+			e.clicks[next].Focus()
+			op.InvalidateOp{}.Add(gtx.Ops)
 		}
-		clk.Add(gtx.Ops)
 	}
+	if e.hovering && e.hovered == key {
+		e.hovering = false
+	}
+	if clk.Hovered() {
+		e.hovered = key
+		e.hovering = true
+	}
+	return dims
+}
 
-	return layout.Dimensions{Size: gtx.Constraints.Min}
+func (e *Enum) selectIndex(gtx layout.Context, idx int) {
+	if new := e.values[idx]; new != e.Value {
+		e.prevValue = e.Value
+		e.Value = new
+		e.changed = true
+		e.changeTime = gtx.Now
+	}
+}
+
+// arrow returns the index moved to by an arrow key press, wrapping at
+// the ends.
+func (e *Enum) arrow(idx int, k key.Event) int {
+	n := len(e.values)
+	switch k.Name {
+	case key.NameUpArrow, key.NameLeftArrow:
+		return (idx + n - 1) % n
+	case key.NameDownArrow, key.NameRightArrow:
+		return (idx + 1) % n
+	}
+	return idx
 }
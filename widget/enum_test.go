@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/io/key"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestEnumArrowKeys(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		e   Enum
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	keys := []string{"a", "b", "c"}
+	frame := func() {
+		ops.Reset()
+		for _, k := range keys {
+			e.Layout(gtx, k)
+		}
+		r.Frame(&ops)
+	}
+	frame()
+	// Tab focuses the first element.
+	r.Queue(key.Event{Name: key.NameTab, State: key.Press})
+	frame()
+	if !e.clicks[0].Focused() {
+		t.Fatal("first element not focused by Tab")
+	}
+	for _, tc := range []struct {
+		name string
+		want int
+	}{
+		{name: key.NameDownArrow, want: 1},
+		{name: key.NameRightArrow, want: 2},
+		{name: key.NameDownArrow, want: 0},
+		{name: key.NameUpArrow, want: 2},
+	} {
+		r.Queue(key.Event{Name: tc.name, State: key.Press})
+		// Moving the focus takes a few frames.
+		for i := 0; i < 3; i++ {
+			frame()
+		}
+		if got, want := e.Value, keys[tc.want]; got != want {
+			t.Errorf("%s: got value %q, expected %q", tc.name, got, want)
+		}
+		if !e.clicks[tc.want].Focused() {
+			t.Errorf("%s: element %d not focused", tc.name, tc.want)
+		}
+		if got, ok := e.Focused(); !ok || got != keys[tc.want] {
+			t.Errorf("%s: got focused key %q, expected %q", tc.name, got, keys[tc.want])
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/widget/anim"
+)
+
+// Expander holds the state of a collapsible section, with a header
+// that toggles the visibility of a body below it.
+type Expander struct {
+	Expanded bool
+	// Header is the state of the clickable header.
+	Header Clickable
+	// Group, if set, makes the expanders of the group mutually
+	// exclusive: expanding one collapses the others.
+	Group *ExpanderGroup
+
+	changed    bool
+	changeTime time.Time
+	// shown is the value of Expanded at the most recent Layout.
+	shown       bool
+	initialized bool
+	progress    float32
+}
+
+// ExpanderGroup is a set of Expanders of which at most one is
+// expanded.
+type ExpanderGroup struct {
+	expanded *Expander
+}
+
+// expandDuration is the duration of the expand and collapse
+// animation.
+const expandDuration = 200 * time.Millisecond
+
+// Changed reports whether Expanded has changed by user interaction
+// since the last call to Changed.
+func (e *Expander) Changed() bool {
+	changed := e.changed
+	e.changed = false
+	return changed
+}
+
+// Progress returns the state of the transition, from 0 when collapsed
+// to 1 when expanded. It is updated by Layout before laying out the
+// header, and is useful for animating an indicator.
+func (e *Expander) Progress() float32 {
+	return e.progress
+}
+
+// Layout lays out header and, below it, the part of body revealed by
+// the transition. The body is clipped to the revealed part.
+func (e *Expander) Layout(gtx layout.Context, header, body layout.Widget) layout.Dimensions {
+	e.update(gtx)
+	hdims := layout.Stack{}.Layout(gtx,
+		layout.Stacked(header),
+		layout.Expanded(e.Header.Layout),
+	)
+	dims := hdims
+	if e.progress == 0 {
+		return dims
+	}
+	macro := op.Record(gtx.Ops)
+	bgtx := gtx
+	bgtx.Constraints.Min.Y = 0
+	bgtx.Constraints.Max.Y -= hdims.Size.Y
+	if bgtx.Constraints.Max.Y < 0 {
+		bgtx.Constraints.Max.Y = 0
+	}
+	bdims := body(bgtx)
+	call := macro.Stop()
+	h := int(float32(bdims.Size.Y)*e.progress + .5)
+	if bdims.Size.X > dims.Size.X {
+		dims.Size.X = bdims.Size.X
+	}
+	dims.Size.Y += h
+	defer op.Save(gtx.Ops).Load()
+	op.Offset(f32.Pt(0, float32(hdims.Size.Y))).Add(gtx.Ops)
+	clip.Rect{Max: image.Pt(bdims.Size.X, h)}.Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	return dims
+}
+
+func (e *Expander) update(gtx layout.Context) {
+	for e.Header.Clicked() {
+		e.Expanded = !e.Expanded
+		e.changed = true
+	}
+	if g := e.Group; g != nil {
+		switch {
+		case e.Expanded && e.shown && g.expanded != nil && g.expanded != e:
+			// Another expander of the group was expanded.
+			e.Expanded = false
+			e.changed = true
+		case e.Expanded:
+			g.expanded = e
+		case g.expanded == e:
+			g.expanded = nil
+		}
+	}
+	if !e.initialized {
+		// Don't animate the initial state.
+		e.initialized = true
+		e.shown = e.Expanded
+	}
+	if e.Expanded != e.shown {
+		e.shown = e.Expanded
+		e.changeTime = gtx.Now
+	}
+	a := anim.Animation{Start: e.changeTime, Duration: expandDuration, Easing: anim.EaseOut}
+	e.progress = a.Progress(gtx)
+	if !e.Expanded {
+		e.progress = 1 - e.progress
+	}
+}
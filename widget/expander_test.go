@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestExpander(t *testing.T) {
+	var (
+		ops   op.Ops
+		r     router.Router
+		group ExpanderGroup
+		exps  = [2]Expander{{Group: &group}, {Group: &group}}
+		dims  [2]layout.Dimensions
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Now:         time.Unix(0, 0),
+		Constraints: layout.Constraints{Max: image.Pt(100, 1000)},
+	}
+	header := func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: image.Pt(100, 20)}
+	}
+	body := func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: image.Pt(100, 50)}
+	}
+	frame := func(e ...event.Event) {
+		r.Frame(&ops)
+		r.Queue(e...)
+		ops.Reset()
+		stack := op.Save(gtx.Ops)
+		for i := range exps {
+			dims[i] = exps[i].Layout(gtx, header, body)
+			op.Offset(f32.Pt(0, float32(dims[i].Size.Y))).Add(gtx.Ops)
+		}
+		stack.Load()
+	}
+	click := func(y float32) []event.Event {
+		return []event.Event{
+			pointer.Event{Type: pointer.Press, Source: pointer.Mouse, Buttons: pointer.ButtonPrimary, Position: f32.Pt(50, y)},
+			pointer.Event{Type: pointer.Release, Source: pointer.Mouse, Position: f32.Pt(50, y)},
+		}
+	}
+	frame()
+	if h := dims[0].Size.Y; h != 20 {
+		t.Errorf("got collapsed height %d, expected 20", h)
+	}
+	frame(click(10)...)
+	frame()
+	if !exps[0].Expanded || !exps[0].Changed() {
+		t.Fatal("click didn't expand")
+	}
+	gtx.Now = gtx.Now.Add(expandDuration / 2)
+	frame()
+	if h := dims[0].Size.Y; h <= 20 || h >= 70 {
+		t.Errorf("got height %d during the transition, expected between 20 and 70", h)
+	}
+	gtx.Now = gtx.Now.Add(expandDuration)
+	frame()
+	if h := dims[0].Size.Y; h != 70 {
+		t.Errorf("got expanded height %d, expected 70", h)
+	}
+	// Expanding the second expander collapses the first.
+	frame(click(80)...)
+	frame()
+	frame()
+	if !exps[1].Expanded || exps[0].Expanded {
+		t.Errorf("got expanded states %v, %v; expected only the second", exps[0].Expanded, exps[1].Expanded)
+	}
+}
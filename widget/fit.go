@@ -36,21 +36,28 @@ const (
 // It positions the widget to the appropriate position.
 // It returns dimensions modified accordingly.
 func (fit Fit) scale(gtx layout.Context, pos layout.Direction, dims layout.Dimensions) layout.Dimensions {
+	dims, trans := fit.transform(gtx.Constraints, pos, dims)
+	clip.Rect{Max: dims.Size}.Add(gtx.Ops)
+	op.Affine(trans).Add(gtx.Ops)
+	return dims
+}
+
+// transform returns the dimensions and the transformation that fit
+// dims to the constraints at the position.
+func (fit Fit) transform(cs layout.Constraints, pos layout.Direction, dims layout.Dimensions) (layout.Dimensions, f32.Affine2D) {
 	widgetSize := dims.Size
 
 	if fit == Unscaled || dims.Size.X == 0 || dims.Size.Y == 0 {
-		dims.Size = gtx.Constraints.Constrain(dims.Size)
-		clip.Rect{Max: dims.Size}.Add(gtx.Ops)
+		dims.Size = cs.Constrain(dims.Size)
 
 		offset := pos.Position(widgetSize, dims.Size)
-		op.Offset(layout.FPt(offset)).Add(gtx.Ops)
 		dims.Baseline += offset.Y
-		return dims
+		return dims, f32.Affine2D{}.Offset(layout.FPt(offset))
 	}
 
 	scale := f32.Point{
-		X: float32(gtx.Constraints.Max.X) / float32(dims.Size.X),
-		Y: float32(gtx.Constraints.Max.Y) / float32(dims.Size.Y),
+		X: float32(cs.Max.X) / float32(dims.Size.X),
+		Y: float32(cs.Max.Y) / float32(dims.Size.Y),
 	}
 
 	switch fit {
@@ -75,13 +82,11 @@ func (fit Fit) scale(gtx layout.Context, pos layout.Direction, dims layout.Dimen
 
 		// The widget would need to be scaled up, no change needed.
 		if scale.X >= 1 {
-			dims.Size = gtx.Constraints.Constrain(dims.Size)
-			clip.Rect{Max: dims.Size}.Add(gtx.Ops)
+			dims.Size = cs.Constrain(dims.Size)
 
 			offset := pos.Position(widgetSize, dims.Size)
-			op.Offset(layout.FPt(offset)).Add(gtx.Ops)
 			dims.Baseline += offset.Y
-			return dims
+			return dims, f32.Affine2D{}.Offset(layout.FPt(offset))
 		}
 	case Fill:
 	}
@@ -89,18 +94,13 @@ func (fit Fit) scale(gtx layout.Context, pos layout.Direction, dims layout.Dimen
 	var scaledSize image.Point
 	scaledSize.X = int(float32(widgetSize.X) * scale.X)
 	scaledSize.Y = int(float32(widgetSize.Y) * scale.Y)
-	dims.Size = gtx.Constraints.Constrain(scaledSize)
+	dims.Size = cs.Constrain(scaledSize)
 	dims.Baseline = int(float32(dims.Baseline) * scale.Y)
 
-	clip.Rect{Max: dims.Size}.Add(gtx.Ops)
-
 	offset := pos.Position(scaledSize, dims.Size)
-	op.Affine(f32.Affine2D{}.
-		Scale(f32.Point{}, scale).
-		Offset(layout.FPt(offset)),
-	).Add(gtx.Ops)
-
 	dims.Baseline += offset.Y
 
-	return dims
+	return dims, f32.Affine2D{}.
+		Scale(f32.Point{}, scale).
+		Offset(layout.FPt(offset))
 }
@@ -4,10 +4,12 @@ package widget
 
 import (
 	"image"
+	"math"
 
 	"gioui.org/f32"
 	"gioui.org/layout"
 	"gioui.org/op"
+	"gioui.org/op/clip"
 	"gioui.org/op/paint"
 	"gioui.org/unit"
 )
@@ -26,8 +28,32 @@ type Image struct {
 	// dps. If Scale is zero Image falls back to
 	// a scale that match a standard 72 DPI.
 	Scale float32
+	// Repeat specifies how the image fills the area around it.
+	// Images that repeat are not scaled by Fit, and fill the
+	// maximum constraints.
+	Repeat Repeat
+	// CornerRadius rounds the corners of the image area.
+	CornerRadius unit.Value
+	// Circle clips the image to the largest circle centered in
+	// the image area. It overrides CornerRadius.
+	Circle bool
 }
 
+// Repeat specifies how an image fills the area outside its bounds.
+type Repeat uint8
+
+const (
+	// NoRepeat leaves the area around the image empty.
+	NoRepeat Repeat = iota
+	// RepeatClamp stretches the edge pixels of the image.
+	RepeatClamp
+	// RepeatTile repeats the image.
+	RepeatTile
+	// RepeatMirror repeats the image, mirroring every other
+	// repetition.
+	RepeatMirror
+)
+
 const defaultScale = float32(160.0 / 72.0)
 
 func (im Image) Layout(gtx layout.Context) layout.Dimensions {
@@ -42,9 +68,23 @@ func (im Image) Layout(gtx layout.Context) layout.Dimensions {
 	wf, hf := float32(size.X), float32(size.Y)
 	w, h := gtx.Px(unit.Dp(wf*scale)), gtx.Px(unit.Dp(hf*scale))
 
-	dims := im.Fit.scale(gtx, im.Position, layout.Dimensions{Size: image.Pt(w, h)})
-
 	pixelScale := scale * gtx.Metric.PxPerDp
+	if im.Repeat != NoRepeat {
+		area := gtx.Constraints.Max
+		im.clip(gtx, area)
+		origin := im.Position.Position(image.Pt(w, h), area)
+		op.Affine(f32.Affine2D{}.Scale(f32.Point{}, f32.Pt(pixelScale, pixelScale))).Add(gtx.Ops)
+		// Convert to image pixels.
+		o := layout.FPt(origin).Mul(1 / pixelScale)
+		a := layout.FPt(area).Mul(1 / pixelScale)
+		im.paintRepeated(gtx.Ops, f32.Rectangle{Min: o, Max: o.Add(layout.FPt(size))}, a)
+		return layout.Dimensions{Size: area}
+	}
+
+	dims, trans := im.Fit.transform(gtx.Constraints, im.Position, layout.Dimensions{Size: image.Pt(w, h)})
+	im.clip(gtx, dims.Size)
+	op.Affine(trans).Add(gtx.Ops)
+
 	op.Affine(f32.Affine2D{}.Scale(f32.Point{}, f32.Pt(pixelScale, pixelScale))).Add(gtx.Ops)
 
 	im.Src.Add(gtx.Ops)
@@ -52,3 +92,104 @@ func (im Image) Layout(gtx layout.Context) layout.Dimensions {
 
 	return dims
 }
+
+// clip the image area of size, rounding its corners.
+func (im Image) clip(gtx layout.Context, size image.Point) {
+	sz := layout.FPt(size)
+	half := sz.X * .5
+	if sz.Y < sz.X {
+		half = sz.Y * .5
+	}
+	if im.Circle {
+		clip.Circle{Center: sz.Mul(.5), Radius: half}.Add(gtx.Ops)
+		return
+	}
+	r := float32(gtx.Px(im.CornerRadius))
+	if r <= 0 {
+		clip.Rect{Max: size}.Add(gtx.Ops)
+		return
+	}
+	if r > half {
+		r = half
+	}
+	clip.UniformRRect(f32.Rectangle{Max: sz}, r).Add(gtx.Ops)
+}
+
+// paintRepeated paints the image at bounds and repeats it over the
+// area from the origin to max.
+func (im Image) paintRepeated(ops *op.Ops, bounds f32.Rectangle, max f32.Point) {
+	size := bounds.Size()
+	if size.X == 0 || size.Y == 0 {
+		return
+	}
+	if im.Repeat == RepeatClamp {
+		// Stretch the pixel centers of the edges to the sides.
+		xs := [4]float32{0, bounds.Min.X, bounds.Max.X, max.X}
+		ys := [4]float32{0, bounds.Min.Y, bounds.Max.Y, max.Y}
+		txs := [3][2]float32{{.5, .5}, {0, size.X}, {size.X - .5, size.X - .5}}
+		tys := [3][2]float32{{.5, .5}, {0, size.Y}, {size.Y - .5, size.Y - .5}}
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				dst := f32.Rect(xs[i], ys[j], xs[i+1], ys[j+1])
+				src := f32.Rect(txs[i][0], tys[j][0], txs[i][1], tys[j][1])
+				paintStretched(ops, im.Src, src, dst)
+			}
+		}
+		return
+	}
+	// Find the range of repetitions covering the area.
+	x0 := int(math.Floor(float64(-bounds.Min.X / size.X)))
+	x1 := int(math.Ceil(float64((max.X - bounds.Min.X) / size.X)))
+	y0 := int(math.Floor(float64(-bounds.Min.Y / size.Y)))
+	y1 := int(math.Ceil(float64((max.Y - bounds.Min.Y) / size.Y)))
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			stack := op.Save(ops)
+			pos := bounds.Min.Add(f32.Pt(float32(x)*size.X, float32(y)*size.Y))
+			t := f32.Affine2D{}
+			if im.Repeat == RepeatMirror {
+				flip := f32.Pt(1, 1)
+				if x&1 != 0 {
+					flip.X = -1
+				}
+				if y&1 != 0 {
+					flip.Y = -1
+				}
+				t = t.Scale(size.Mul(.5), flip)
+			}
+			op.Affine(t.Offset(pos)).Add(ops)
+			im.Src.Add(ops)
+			paint.PaintOp{}.Add(ops)
+			stack.Load()
+		}
+	}
+}
+
+// paintStretched paints the src rectangle of an image into the dst
+// rectangle. The src rectangle may be empty in either
+// direction, which stretches a single row or column of pixels.
+func paintStretched(ops *op.Ops, img paint.ImageOp, src, dst f32.Rectangle) {
+	if dst.Dx() <= 0 || dst.Dy() <= 0 {
+		return
+	}
+	// Sample a sliver of empty source rectangles.
+	const sliver = 1.0 / 64
+	if src.Dx() < sliver {
+		c := (src.Min.X + src.Max.X) * .5
+		src.Min.X, src.Max.X = c-sliver*.5, c+sliver*.5
+	}
+	if src.Dy() < sliver {
+		c := (src.Min.Y + src.Max.Y) * .5
+		src.Min.Y, src.Max.Y = c-sliver*.5, c+sliver*.5
+	}
+	defer op.Save(ops).Load()
+	clip.RRect{Rect: dst}.Add(ops)
+	scale := f32.Pt(dst.Dx()/src.Dx(), dst.Dy()/src.Dy())
+	op.Affine(f32.Affine2D{}.
+		Offset(src.Min.Mul(-1)).
+		Scale(f32.Point{}, scale).
+		Offset(dst.Min),
+	).Add(ops)
+	img.Add(ops)
+	paint.PaintOp{}.Add(ops)
+}
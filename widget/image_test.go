@@ -9,6 +9,7 @@ import (
 	"gioui.org/layout"
 	"gioui.org/op"
 	"gioui.org/op/paint"
+	"gioui.org/unit"
 )
 
 func TestImageScale(t *testing.T) {
@@ -64,3 +65,39 @@ func TestImageScale(t *testing.T) {
 		t.Fatalf("HiDPI .5 scale image is wrong size, expected %v, got %v", expectedSize, dims.Size)
 	}
 }
+
+func TestImageRepeat(t *testing.T) {
+	var ops op.Ops
+	gtx := layout.Context{
+		Ops: &ops,
+		Constraints: layout.Constraints{
+			Max: image.Pt(50, 40),
+		},
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	imgOp := paint.NewImageOp(img)
+	for _, r := range []Repeat{RepeatClamp, RepeatTile, RepeatMirror} {
+		dims := Image{Src: imgOp, Repeat: r, Position: layout.Center}.Layout(gtx)
+		if got, want := dims.Size, gtx.Constraints.Max; got != want {
+			t.Errorf("repeat %d: got size %v, want %v", r, got, want)
+		}
+	}
+}
+
+func TestImageRounded(t *testing.T) {
+	var ops op.Ops
+	gtx := layout.Context{
+		Ops:         &ops,
+		Constraints: layout.Exact(image.Pt(40, 40)),
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	imgOp := paint.NewImageOp(img)
+	for _, im := range []Image{
+		{Src: imgOp, Fit: Cover, CornerRadius: unit.Dp(8)},
+		{Src: imgOp, Fit: Cover, Circle: true},
+	} {
+		if got, want := im.Layout(gtx).Size, gtx.Constraints.Max; got != want {
+			t.Errorf("got size %v, want %v", got, want)
+		}
+	}
+}
@@ -5,6 +5,8 @@ package widget
 import (
 	"fmt"
 	"image"
+	"strings"
+	"unicode"
 	"unicode/utf8"
 
 	"gioui.org/layout"
@@ -22,7 +24,20 @@ type Label struct {
 	// Alignment specify the text alignment.
 	Alignment text.Alignment
 	// MaxLines limits the number of lines. Zero means no limit.
+	// Text beyond the limit is cut and replaced by Truncator.
 	MaxLines int
+	// Truncator is the text that ends text cut by MaxLines. If
+	// empty, an ellipsis is used.
+	Truncator string
+	// Direction is the direction of the text. Start and End
+	// alignments are flipped for right-to-left text.
+	Direction text.Direction
+	// LetterSpacing is the extra space between characters. Negative
+	// values tighten the text.
+	LetterSpacing unit.Value
+	// LineHeight is the distance between the baselines of lines. If
+	// zero, the line height of the font is used.
+	LineHeight unit.Value
 }
 
 // screenPos describes a character position (in text line and column numbers,
@@ -157,18 +172,53 @@ func (p1 screenPos) Less(p2 screenPos) bool {
 func (l Label) Layout(gtx layout.Context, s text.Shaper, font text.Font, size unit.Value, txt string) layout.Dimensions {
 	cs := gtx.Constraints
 	textSize := fixed.I(gtx.Px(size))
-	lines := s.LayoutString(font, textSize, cs.Max.X, txt)
+	var lines []text.Line
+	if l.LetterSpacing.V != 0 {
+		// Measure the spacing in sub-pixels.
+		spacing := fixed.Int26_6(gtx.Px(l.LetterSpacing.Scale(64)))
+		lines = layoutSpaced(s, font, textSize, cs.Max.X, spacing, txt)
+	} else {
+		lines = s.LayoutString(font, textSize, cs.Max.X, txt)
+	}
 	if max := l.MaxLines; max > 0 && len(lines) > max {
-		lines = lines[:max]
+		truncated := make([]text.Line, max)
+		copy(truncated, lines)
+		truncator := l.Truncator
+		if truncator == "" {
+			truncator = "…"
+		}
+		truncated[max-1] = ellipsize(s, font, textSize, cs.Max.X, truncated[max-1], truncator)
+		lines = truncated
+	}
+	if l.Alignment == text.Justify {
+		lines = justify(lines, cs.Max.X)
+	}
+	if l.LineHeight.V != 0 {
+		lines = lead(lines, fixed.Int26_6(gtx.Px(l.LineHeight.Scale(64))))
+	}
+	dir := text.ResolveDirection(txt, l.Direction)
+	lines = reorder(lines, dir)
+	alignment := l.Alignment
+	if dir == text.RTL {
+		switch alignment {
+		case text.Start:
+			alignment = text.End
+		case text.End:
+			alignment = text.Start
+		}
 	}
 	dims := linesDimens(lines)
+	// The text is drawn from the top; keep the baseline relative to
+	// it.
+	top := dims.Size.Y - dims.Baseline
 	dims.Size = cs.Constrain(dims.Size)
+	dims.Baseline = dims.Size.Y - top
 	cl := textPadding(lines)
 	cl.Max = cl.Max.Add(dims.Size)
 	it := segmentIterator{
 		Lines:     lines,
 		Clip:      cl,
-		Alignment: l.Alignment,
+		Alignment: alignment,
 		Width:     dims.Size.X,
 	}
 	for {
@@ -186,6 +236,170 @@ func (l Label) Layout(gtx layout.Context, s text.Shaper, font text.Font, size un
 	return dims
 }
 
+// ellipsize cuts the end of line and appends the ellipsis such that
+// the line fits in maxWidth.
+func ellipsize(s text.Shaper, font text.Font, size fixed.Int26_6, maxWidth int, line text.Line, ellipsis string) text.Line {
+	el := s.LayoutString(font, size, inf, ellipsis)
+	if len(el) == 0 {
+		return line
+	}
+	e := el[0]
+	txt, advs := line.Layout.Text, line.Layout.Advances
+	w := line.Width
+	// Remove runes until the ellipsis fits, along with any trailing
+	// space.
+	for len(advs) > 0 {
+		r, n := utf8.DecodeLastRuneInString(txt)
+		if w+e.Width <= fixed.I(maxWidth) && !unicode.IsSpace(r) {
+			break
+		}
+		w -= advs[len(advs)-1]
+		advs = advs[:len(advs)-1]
+		txt = txt[:len(txt)-n]
+	}
+	line.Layout = text.Layout{
+		Text:     txt + e.Layout.Text,
+		Advances: append(advs[:len(advs):len(advs)], e.Layout.Advances...),
+	}
+	line.Width = w + e.Width
+	line.Bounds.Max.X = w + e.Bounds.Max.X
+	if e.Ascent > line.Ascent {
+		line.Ascent = e.Ascent
+	}
+	if e.Descent > line.Descent {
+		line.Descent = e.Descent
+	}
+	return line
+}
+
+// layoutSpaced lays out txt with spacing added between characters. The
+// text is laid out at decreasing widths until the spaced lines fit
+// maxWidth.
+func layoutSpaced(s text.Shaper, font text.Font, size fixed.Int26_6, maxWidth int, spacing fixed.Int26_6, txt string) []text.Line {
+	const attempts = 4
+	width := maxWidth
+	var spaced []text.Line
+	for i := 0; i < attempts; i++ {
+		lines := s.LayoutString(font, size, width, txt)
+		spaced = make([]text.Line, len(lines))
+		var overflow fixed.Int26_6
+		for j, l := range lines {
+			n := len(l.Layout.Advances)
+			if n > 1 {
+				advs := make([]fixed.Int26_6, n)
+				copy(advs, l.Layout.Advances)
+				for k := range advs[:n-1] {
+					advs[k] += spacing
+				}
+				extra := spacing * fixed.Int26_6(n-1)
+				l.Layout.Advances = advs
+				l.Width += extra
+				l.Bounds.Max.X += extra
+			}
+			// Trailing space may overflow, as in unspaced text.
+			if o := visibleWidth(l) - fixed.I(maxWidth); o > overflow && n > 1 {
+				overflow = o
+			}
+			spaced[j] = l
+		}
+		if overflow == 0 || spacing < 0 {
+			break
+		}
+		width -= overflow.Ceil()
+		if width <= 0 {
+			break
+		}
+	}
+	return spaced
+}
+
+// reorder converts lines to visual order for a paragraph direction.
+func reorder(lines []text.Line, dir text.Direction) []text.Line {
+	var reordered []text.Line
+	for i, l := range lines {
+		lay := text.Reorder(l.Layout, dir)
+		if lay.Text == l.Layout.Text {
+			continue
+		}
+		if reordered == nil {
+			reordered = make([]text.Line, len(lines))
+			copy(reordered, lines)
+		}
+		reordered[i].Layout = lay
+	}
+	if reordered == nil {
+		return lines
+	}
+	return reordered
+}
+
+// justify stretches the spaces between words to make lines fill width.
+// The last line of every paragraph and lines without spaces between
+// words are left unchanged.
+func justify(lines []text.Line, width int) []text.Line {
+	justified := make([]text.Line, len(lines))
+	copy(justified, lines)
+	for i := range justified[:max(len(justified)-1, 0)] {
+		l := &justified[i]
+		txt := l.Layout.Text
+		if strings.HasSuffix(txt, "\n") {
+			continue
+		}
+		words := strings.TrimRightFunc(txt, unicode.IsSpace)
+		visible := visibleWidth(*l)
+		spaces := 0
+		for _, r := range words {
+			if unicode.IsSpace(r) {
+				spaces++
+			}
+		}
+		extra := fixed.I(width) - visible
+		if spaces == 0 || extra <= 0 {
+			continue
+		}
+		advs := make([]fixed.Int26_6, len(l.Layout.Advances))
+		copy(advs, l.Layout.Advances)
+		n, s := 0, 0
+		for _, r := range words {
+			if unicode.IsSpace(r) {
+				// Distribute the remainder of the division among
+				// the spaces.
+				advs[n] += extra*fixed.Int26_6(s+1)/fixed.Int26_6(spaces) - extra*fixed.Int26_6(s)/fixed.Int26_6(spaces)
+				s++
+			}
+			n++
+		}
+		l.Layout.Advances = advs
+		l.Width += extra
+		l.Bounds.Max.X += extra
+	}
+	return justified
+}
+
+// lead adjusts the ascent and descent of lines to make their height
+// equal to height, dividing the difference equally above and below.
+func lead(lines []text.Line, height fixed.Int26_6) []text.Line {
+	led := make([]text.Line, len(lines))
+	for i, l := range lines {
+		extra := height - (l.Ascent + l.Descent)
+		half := extra / 2
+		l.Ascent += half
+		l.Descent += extra - half
+		led[i] = l
+	}
+	return led
+}
+
+// visibleWidth returns the width of l without its trailing space.
+func visibleWidth(l text.Line) fixed.Int26_6 {
+	words := strings.TrimRightFunc(l.Layout.Text, unicode.IsSpace)
+	w := l.Width
+	for _, adv := range l.Layout.Advances[utf8.RuneCountInString(words):] {
+		w -= adv
+	}
+	return w
+}
+
 func textPadding(lines []text.Line) (padding image.Rectangle) {
 	if len(lines) == 0 {
 		return
@@ -240,7 +454,7 @@ func align(align text.Alignment, width fixed.Int26_6, maxWidth int) fixed.Int26_
 		return fixed.I(((mw - width) / 2).Floor())
 	case text.End:
 		return fixed.I((mw - width).Floor())
-	case text.Start:
+	case text.Start, text.Justify:
 		return 0
 	default:
 		panic(fmt.Errorf("unknown alignment %v", align))
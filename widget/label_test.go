@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+
+	"gioui.org/font/gofont"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/text"
+	"gioui.org/unit"
+
+	"golang.org/x/image/math/fixed"
+)
+
+func TestLabelMaxLines(t *testing.T) {
+	gtx := layout.Context{
+		Ops: new(op.Ops),
+		Constraints: layout.Constraints{
+			Max: image.Pt(100, 1000),
+		},
+	}
+	cache := text.NewCache(gofont.Collection())
+	font := text.Font{}
+	size := unit.Px(10)
+	long := "The quick brown fox jumps over the lazy dog, again and again and again."
+
+	line := Label{}.Layout(gtx, cache, font, size, "x")
+	all := Label{}.Layout(gtx, cache, font, size, long)
+	if all.Size.Y <= line.Size.Y {
+		t.Fatalf("text did not wrap: got height %d, line height %d", all.Size.Y, line.Size.Y)
+	}
+	cut := Label{MaxLines: 1}.Layout(gtx, cache, font, size, long)
+	if cut.Size.Y != line.Size.Y {
+		t.Errorf("got height %d for one line, want %d", cut.Size.Y, line.Size.Y)
+	}
+	if cut.Size.X > gtx.Constraints.Max.X {
+		t.Errorf("truncated line is %d wide, exceeds %d", cut.Size.X, gtx.Constraints.Max.X)
+	}
+	lines := cache.LayoutString(font, 10<<6, gtx.Constraints.Max.X, long)
+	l := ellipsize(cache, font, 10<<6, gtx.Constraints.Max.X, lines[0], "…")
+	if got := l.Layout.Text; got[len(got)-len("…"):] != "…" {
+		t.Errorf("got truncated text %q, expected an ellipsis", got)
+	}
+	if len([]rune(l.Layout.Text)) != len(l.Layout.Advances) {
+		t.Errorf("got %d advances for %q", len(l.Layout.Advances), l.Layout.Text)
+	}
+	custom := Label{MaxLines: 1, Truncator: " [more]"}.Layout(gtx, cache, font, size, long)
+	if custom.Size.X > gtx.Constraints.Max.X {
+		t.Errorf("custom truncated line is %d wide, exceeds %d", custom.Size.X, gtx.Constraints.Max.X)
+	}
+}
+
+func TestLabelBaseline(t *testing.T) {
+	cache := text.NewCache(gofont.Collection())
+	gtx := layout.Context{
+		Ops:         new(op.Ops),
+		Constraints: layout.Constraints{Max: image.Pt(100, 100)},
+	}
+	dims := Label{}.Layout(gtx, cache, text.Font{}, unit.Px(10), "text")
+	top := dims.Size.Y - dims.Baseline
+	if dims.Baseline <= 0 || top <= 0 {
+		t.Fatalf("got baseline %d for height %d", dims.Baseline, dims.Size.Y)
+	}
+	// A taller label keeps the baseline of its first line.
+	gtx.Constraints.Min.Y = 50
+	dims = Label{}.Layout(gtx, cache, text.Font{}, unit.Px(10), "text")
+	if got := dims.Size.Y - dims.Baseline; got != top {
+		t.Errorf("got baseline %d from the top of a taller label, want %d", got, top)
+	}
+}
+
+func TestLabelJustify(t *testing.T) {
+	cache := text.NewCache(gofont.Collection())
+	const width = 100
+	long := "The quick brown fox jumps over the lazy dog, again and again.\nEnd"
+	lines := justify(cache.LayoutString(text.Font{}, 10<<6, width, long), width)
+	if len(lines) < 3 {
+		t.Fatalf("got %d lines, expected the text to wrap", len(lines))
+	}
+	for i, l := range lines {
+		words := strings.TrimRightFunc(l.Layout.Text, unicode.IsSpace)
+		var w fixed.Int26_6
+		for _, adv := range l.Layout.Advances[:utf8.RuneCountInString(words)] {
+			w += adv
+		}
+		last := i == len(lines)-1 || strings.HasSuffix(l.Layout.Text, "\n")
+		if got := w.Round(); !last && got != width {
+			t.Errorf("line %d: got width %d, want %d", i, got, width)
+		}
+		if last && w > fixed.I(width) {
+			t.Errorf("last line %d: got width %v, exceeds %d", i, w, width)
+		}
+	}
+}
+
+func TestLabelLetterSpacing(t *testing.T) {
+	cache := text.NewCache(gofont.Collection())
+	const width = 100
+	long := "The quick brown fox jumps over the lazy dog, again and again."
+	plain := cache.LayoutString(text.Font{}, 10<<6, width, long)
+	spaced := layoutSpaced(cache, text.Font{}, 10<<6, width, 2<<6, long)
+	if len(spaced) <= len(plain) {
+		t.Errorf("got %d spaced lines, expected more than %d", len(spaced), len(plain))
+	}
+	for i, l := range spaced {
+		words := strings.TrimRightFunc(l.Layout.Text, unicode.IsSpace)
+		var w fixed.Int26_6
+		for _, adv := range l.Layout.Advances[:utf8.RuneCountInString(words)] {
+			w += adv
+		}
+		if w > fixed.I(width) {
+			t.Errorf("line %d: got width %v, exceeds %d", i, w, width)
+		}
+	}
+}
+
+func TestLabelLineHeight(t *testing.T) {
+	gtx := layout.Context{
+		Ops: new(op.Ops),
+		Constraints: layout.Constraints{
+			Max: image.Pt(1000, 1000),
+		},
+	}
+	cache := text.NewCache(gofont.Collection())
+	const lineHeight = 30
+	dims := Label{LineHeight: unit.Px(lineHeight)}.Layout(gtx, cache, text.Font{}, unit.Px(10), "one\ntwo\nthree")
+	if got, want := dims.Size.Y, 3*lineHeight; got < want-1 || got > want+1 {
+		t.Errorf("got height %d, want %d", got, want)
+	}
+}
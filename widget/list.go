@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/gesture"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Scrollbar holds the persistent state of a scrollbar.
+type Scrollbar struct {
+	drag gesture.Drag
+	// dragPos is the most recent position of the drag along the
+	// scrollbar, in pixels.
+	dragPos float32
+	// delta is the scroll distance accumulated since the last
+	// call to ScrollDistance, as a fraction of the content.
+	delta float32
+
+	start      float32
+	lastActive time.Time
+}
+
+// List holds the persistent state of a list with a scrollbar.
+type List struct {
+	Scrollbar
+	layout.List
+}
+
+// Layout updates the scrollbar state from input on an area of the
+// minimum constraints. The viewport start and end are the visible
+// fraction of the content, in the range [0, 1].
+//
+// Pressing the scrollbar outside the viewport scrolls the viewport
+// to center on the press. Dragging scrolls the viewport along.
+func (s *Scrollbar) Layout(gtx layout.Context, axis layout.Axis, viewportStart, viewportEnd float32) layout.Dimensions {
+	size := gtx.Constraints.Min
+	length := float32(axis.Convert(size).X)
+	if viewportStart != s.start {
+		s.start = viewportStart
+		s.lastActive = gtx.Now
+	}
+	for _, e := range s.drag.Events(gtx.Metric, gtx, gesture.Axis(axis)) {
+		if length == 0 {
+			break
+		}
+		pos := e.Position.X
+		if axis == layout.Vertical {
+			pos = e.Position.Y
+		}
+		switch e.Type {
+		case pointer.Press:
+			if p := pos / length; p < viewportStart || p > viewportEnd {
+				s.delta += p - (viewportStart+viewportEnd)*.5
+			}
+		case pointer.Drag:
+			s.delta += (pos - s.dragPos) / length
+		}
+		s.dragPos = pos
+		s.lastActive = gtx.Now
+	}
+
+	defer op.Save(gtx.Ops).Load()
+	pointer.Rect(image.Rectangle{Max: size}).Add(gtx.Ops)
+	s.drag.Add(gtx.Ops)
+	return layout.Dimensions{Size: size}
+}
+
+// ScrollDistance returns the distance the scrollbar was scrolled
+// since the last call to ScrollDistance, as a fraction of the content.
+func (s *Scrollbar) ScrollDistance() float32 {
+	d := s.delta
+	s.delta = 0
+	return d
+}
+
+// Dragging reports whether the scrollbar is being dragged.
+func (s *Scrollbar) Dragging() bool {
+	return s.drag.Dragging()
+}
+
+// LastActive returns the time of the most recent change of the
+// viewport or input to the scrollbar. It is useful for hiding an
+// idle scrollbar.
+func (s *Scrollbar) LastActive() time.Time {
+	return s.lastActive
+}
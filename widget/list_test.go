@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestScrollbarPress(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		s   Scrollbar
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(10, 100)),
+	}
+	s.Layout(gtx, layout.Vertical, 0, .2)
+	r.Frame(&ops)
+	r.Queue(
+		pointer.Event{
+			Source:   pointer.Mouse,
+			Buttons:  pointer.ButtonPrimary,
+			Type:     pointer.Press,
+			Position: f32.Pt(5, 60),
+		},
+		pointer.Event{
+			Source:   pointer.Mouse,
+			Buttons:  pointer.ButtonPrimary,
+			Type:     pointer.Drag,
+			Position: f32.Pt(5, 70),
+		},
+	)
+	ops.Reset()
+	s.Layout(gtx, layout.Vertical, 0, .2)
+	if !s.Dragging() {
+		t.Error("scrollbar not dragging after press")
+	}
+	// The press centers the viewport at .6, the drag moves it by .1.
+	if got, exp := s.ScrollDistance(), float32(.6); got < exp-1e-3 || got > exp+1e-3 {
+		t.Errorf("got scroll distance %v, expected %v", got, exp)
+	}
+	if got := s.ScrollDistance(); got != 0 {
+		t.Errorf("got scroll distance %v after reset, expected 0", got)
+	}
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// AppBarAction is an icon button of an app bar.
+type AppBarAction struct {
+	// Name describes the action, and is the text of the action in
+	// the overflow menu.
+	Name   string
+	Icon   *widget.Icon
+	Button *widget.Clickable
+}
+
+// AppBarStyle defines the presentation of a bar at the top of the
+// screen, with an optional leading navigation button, a title and
+// trailing actions. Actions that don't fit the bar collapse into an
+// overflow menu, whose choices click the button of the action.
+type AppBarStyle struct {
+	// Leading is the optional navigation button before the title.
+	Leading *AppBarAction
+	Title   string
+	Actions []AppBarAction
+	// Color is the title and icon color.
+	Color      color.NRGBA
+	Background color.NRGBA
+	// DisabledColor is the color that the bar fades towards when
+	// disabled.
+	DisabledColor color.NRGBA
+	Height        unit.Value
+	Elevation     unit.Value
+	Font          text.Font
+	TextSize      unit.Value
+	// OverflowIcon is the icon of the overflow menu button.
+	OverflowIcon *widget.Icon
+	// Menu is the style of the overflow menu. Its items are the
+	// actions that don't fit.
+	Menu   MenuStyle
+	AppBar *widget.AppBar
+	shaper text.Shaper
+}
+
+// AppBar returns an app bar with a title and actions.
+func AppBar(th *Theme, state *widget.AppBar, title string, actions ...AppBarAction) AppBarStyle {
+	return AppBarStyle{
+		Title:         title,
+		Actions:       actions,
+		Color:         th.Palette.ContrastFg,
+		Background:    th.Palette.ContrastBg,
+		DisabledColor: th.Palette.Disabled,
+		Height:        unit.Dp(56),
+		Elevation:     unit.Dp(4),
+		TextSize:      th.TextStyleSize(TextStyleH6),
+		OverflowIcon:  th.Icon.MoreVert,
+		Menu:          Menu(th, &state.Menu),
+		AppBar:        state,
+		shaper:        th.Shaper,
+	}
+}
+
+// Layout the bar over the maximum width. The bar extends under the
+// top insets of the context, and pads its content by the top, left
+// and right insets. The dimensions include the room for the shadow
+// below the bar. The overflow menu is drawn on top of all other
+// content and kept within the maximum constraints, which should be
+// the bounds of the window.
+func (a AppBarStyle) Layout(gtx layout.Context) layout.Dimensions {
+	state := a.AppBar
+	top := gtx.Px(gtx.Insets.Top)
+	left := gtx.Px(gtx.Insets.Left)
+	// size is the size of the content, inside the insets.
+	size := image.Pt(gtx.Constraints.Max.X-left-gtx.Px(gtx.Insets.Right), gtx.Px(a.Height))
+	if size.X < 0 {
+		size.X = 0
+	}
+	bar := image.Pt(gtx.Constraints.Max.X, top+size.Y)
+	edge := gtx.Px(unit.Dp(4))
+	button := gtx.Px(unit.Dp(48))
+
+	// Find the actions that fit the bar. The title is laid out in
+	// the remaining space, and truncated if it doesn't fit.
+	avail := size.X - 2*edge - gtx.Px(unit.Dp(16))
+	if a.Leading != nil {
+		avail -= button + gtx.Px(unit.Dp(20))
+	}
+	visible := a.Actions
+	var hidden []AppBarAction
+	if n := avail / button; n < len(a.Actions) {
+		// Make room for the overflow button.
+		n--
+		if n < 0 {
+			n = 0
+		}
+		visible, hidden = a.Actions[:n], a.Actions[n:]
+	}
+	if i, ok := state.Menu.Chosen(); ok && i < len(hidden) {
+		hidden[i].Button.Click()
+	}
+	if len(hidden) == 0 {
+		state.Menu.Close()
+	}
+	for state.Overflow.Clicked() {
+		state.Menu.Open(image.Pt(left+size.X-edge-button, top))
+	}
+
+	// Reserve room for the shadow below the bar.
+	shadow := image.Pt(bar.X, bar.Y+gtx.Px(ShadowInset(a.Elevation).Bottom))
+	if gtx.Queue != nil {
+		r := f32.Rectangle{Max: layout.FPt(bar)}
+		drawShadow(gtx, clip.RRect{Rect: r}, float32(gtx.Px(a.Elevation)), image.Rectangle{Max: shadow})
+	}
+	paint.FillShape(gtx.Ops, blendDisabledColor(gtx.Queue == nil, a.DisabledColor, a.Background), clip.Rect{Max: bar}.Op())
+	var children []layout.FlexChild
+	children = append(children, layout.Rigid(layout.Spacer{Width: unit.Dp(4)}.Layout))
+	if a.Leading != nil {
+		leading := *a.Leading
+		children = append(children,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return a.iconButton(leading).Layout(gtx)
+			}),
+			layout.Rigid(layout.Spacer{Width: unit.Dp(20)}.Layout),
+		)
+	} else {
+		children = append(children, layout.Rigid(layout.Spacer{Width: unit.Dp(12)}.Layout))
+	}
+	children = append(children, layout.Flexed(1, a.layoutTitle))
+	for _, act := range visible {
+		act := act
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return a.iconButton(act).Layout(gtx)
+		}))
+	}
+	if len(hidden) > 0 {
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return a.iconButton(AppBarAction{
+				Name:   "More options",
+				Icon:   a.OverflowIcon,
+				Button: &state.Overflow,
+			}).Layout(gtx)
+		}))
+	}
+	children = append(children, layout.Rigid(layout.Spacer{Width: unit.Dp(4)}.Layout))
+	// Center the row vertically.
+	fgtx := gtx
+	fgtx.Constraints = layout.Constraints{Min: image.Pt(size.X, 0), Max: size}
+	macro := op.Record(gtx.Ops)
+	dims := layout.Flex{Alignment: layout.Middle}.Layout(fgtx, children...)
+	call := macro.Stop()
+	stack := op.Save(gtx.Ops)
+	op.Offset(f32.Pt(float32(left), float32(top)+float32(size.Y-dims.Size.Y)/2)).Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	stack.Load()
+
+	if state.Menu.Visible() {
+		m := a.Menu
+		m.Items = make([]MenuItem, len(hidden))
+		for i, act := range hidden {
+			m.Items[i] = MenuItem{Text: act.Name, Icon: act.Icon}
+		}
+		macro := op.Record(gtx.Ops)
+		m.Layout(gtx)
+		op.Defer(gtx.Ops, macro.Stop())
+	}
+	return layout.Dimensions{Size: shadow}
+}
+
+func (a AppBarStyle) layoutTitle(gtx layout.Context) layout.Dimensions {
+	paint.ColorOp{Color: blendDisabledColor(gtx.Queue == nil, a.DisabledColor, a.Color)}.Add(gtx.Ops)
+	return widget.Label{MaxLines: 1}.Layout(gtx, a.shaper, a.Font, a.TextSize, a.Title)
+}
+
+// iconButton returns the transparent icon button of act.
+func (a AppBarStyle) iconButton(act AppBarAction) IconButtonStyle {
+	return IconButtonStyle{
+		Color:         a.Color,
+		Icon:          act.Icon,
+		Size:          unit.Dp(24),
+		InkDuration:   defaultInkDuration,
+		CenteredInk:   true,
+		Inset:         layout.UniformInset(unit.Dp(12)),
+		Button:        act.Button,
+		Focusable:     true,
+		DisabledColor: a.DisabledColor,
+		Description:   act.Name,
+	}
+}
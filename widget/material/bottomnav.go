@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/internal/f32color"
+	"gioui.org/io/semantic"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// BottomNavStyle defines the presentation of a bar of destinations
+// at the bottom of the screen, of which the Enum value is selected.
+// The Enum reports changes of the selected destination.
+type BottomNavStyle struct {
+	Nav   *widget.Enum
+	Items []NavItem
+	// Background is the color of the bar.
+	Background color.NRGBA
+	// Color is the icon and label color of unselected destinations.
+	Color color.NRGBA
+	// SelectedColor is the icon and label color of the selected
+	// destination.
+	SelectedColor color.NRGBA
+	DisabledColor color.NRGBA
+	IconSize      unit.Value
+	Font          text.Font
+	TextSize      unit.Value
+	InkColor      color.NRGBA
+	Inset         layout.Inset
+	shaper        text.Shaper
+}
+
+// NavItem is a destination of a BottomNavStyle.
+type NavItem struct {
+	// Key is the Enum value of the destination.
+	Key   string
+	Label string
+	Icon  *widget.Icon
+}
+
+// BottomNav returns a navigation bar for the destinations items, from
+// three to five of them.
+func BottomNav(th *Theme, nav *widget.Enum, items ...NavItem) BottomNavStyle {
+	return BottomNavStyle{
+		Nav:           nav,
+		Items:         items,
+		Background:    th.Palette.Surface,
+		Color:         f32color.MulAlpha(th.Palette.OnSurface, 0xbb),
+		SelectedColor: th.Palette.ContrastBg,
+		DisabledColor: th.Palette.Disabled,
+		IconSize:      unit.Dp(24),
+		TextSize:      th.TextStyleSize(TextStyleCaption),
+		Inset: layout.Inset{
+			Top: unit.Dp(8), Bottom: unit.Dp(8),
+			Left: unit.Dp(12), Right: unit.Dp(12),
+		},
+		shaper: th.Shaper,
+	}
+}
+
+// Layout the bar over the maximum width, with a destination in each
+// of the equally wide parts. The bar extends under the bottom insets
+// of the context, and pads the destinations by the bottom, left and
+// right insets.
+func (b BottomNavStyle) Layout(gtx layout.Context) layout.Dimensions {
+	in := layout.Inset{
+		Bottom: gtx.Insets.Bottom,
+		Left:   gtx.Insets.Left,
+		Right:  gtx.Insets.Right,
+	}
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	children := make([]layout.FlexChild, len(b.Items))
+	for i := range b.Items {
+		item := b.Items[i]
+		children[i] = layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+			return b.layoutItem(gtx, item)
+		})
+	}
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			bg := blendDisabledColor(gtx.Queue == nil, b.DisabledColor, b.Background)
+			paint.FillShape(gtx.Ops, bg, clip.Rect{Max: gtx.Constraints.Min}.Op())
+			return layout.Dimensions{Size: gtx.Constraints.Min}
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			return in.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{}.Layout(gtx, children...)
+			})
+		}),
+	)
+}
+
+// layoutItem lays out the icon, label, background and input handler
+// of a destination.
+func (b BottomNavStyle) layoutItem(gtx layout.Context, item NavItem) layout.Dimensions {
+	state := b.Nav
+	selected := state.Value == item.Key
+	// progress is the transition towards the selected color.
+	var progress float32
+	if selected {
+		progress = 1
+	}
+	if selected || state.Previous() == item.Key {
+		if dt := gtx.Now.Sub(state.ChangeTime()); dt < toggleDuration {
+			p := float32(dt.Seconds() / toggleDuration.Seconds())
+			if !selected {
+				p = 1 - p
+			}
+			progress = p
+			op.InvalidateOp{}.Add(gtx.Ops)
+		}
+	}
+	col := blendDisabledColor(gtx.Queue == nil, b.DisabledColor, f32color.Lerp(b.Color, b.SelectedColor, progress))
+	dims := layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			defer op.Save(gtx.Ops).Load()
+			clip.Rect{Max: gtx.Constraints.Min}.Add(gtx.Ops)
+			if key, ok := state.Hovered(); ok && key == item.Key && gtx.Queue != nil {
+				paint.FillShape(gtx.Ops, f32color.MulAlpha(b.SelectedColor, 0x14), clip.Rect{Max: gtx.Constraints.Min}.Op())
+			}
+			drawInks(gtx, state.History(item.Key), b.InkColor, defaultInkDuration)
+			return state.Layout(gtx, item.Key)
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			gtx.Constraints.Min.X = gtx.Constraints.Max.X
+			return b.Inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Axis: layout.Vertical, Alignment: layout.Middle}.Layout(gtx,
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						if item.Icon == nil {
+							size := gtx.Px(b.IconSize)
+							return layout.Dimensions{Size: image.Pt(size, size)}
+						}
+						item.Icon.Color = col
+						return item.Icon.Layout(gtx, b.IconSize)
+					}),
+					layout.Rigid(layout.Spacer{Height: unit.Dp(4)}.Layout),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						paint.ColorOp{Color: col}.Add(gtx.Ops)
+						return widget.Label{Alignment: text.Middle, MaxLines: 1}.Layout(gtx, b.shaper, b.Font, b.TextSize, item.Label)
+					}),
+				)
+			})
+		}),
+	)
+	describe(gtx, dims.Size, description{class: semantic.RadioButton, label: item.Label, selected: selected})
+	return dims
+}
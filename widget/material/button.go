@@ -6,10 +6,12 @@ import (
 	"image"
 	"image/color"
 	"math"
+	"time"
 
 	"gioui.org/f32"
 	"gioui.org/internal/f32color"
 	"gioui.org/io/pointer"
+	"gioui.org/io/semantic"
 	"gioui.org/layout"
 	"gioui.org/op"
 	"gioui.org/op/clip"
@@ -17,6 +19,7 @@ import (
 	"gioui.org/text"
 	"gioui.org/unit"
 	"gioui.org/widget"
+	"gioui.org/widget/anim"
 )
 
 type ButtonStyle struct {
@@ -27,15 +30,93 @@ type ButtonStyle struct {
 	TextSize     unit.Value
 	Background   color.NRGBA
 	CornerRadius unit.Value
-	Inset        layout.Inset
-	Button       *widget.Clickable
-	shaper       text.Shaper
+	// Corners overrides CornerRadius with individual corner radii,
+	// if any of them are non-zero.
+	Corners CornerRadii
+	// BackgroundGradient, if it has stops, replaces Background with
+	// a linear gradient.
+	BackgroundGradient Gradient
+	// BorderColor and BorderWidth describe the outline drawn along
+	// the button edge. A zero BorderWidth draws no outline.
+	BorderColor color.NRGBA
+	BorderWidth unit.Value
+	// Elevation is the height of the button above the surface,
+	// and determines the size of its shadow. The dimensions of the
+	// button include the room for the shadow.
+	Elevation unit.Value
+	// PressedBackground, if set, replaces Background while the
+	// button is pressed.
+	PressedBackground color.NRGBA
+	// FocusColor is the color of the ring drawn when the button
+	// has keyboard focus.
+	FocusColor color.NRGBA
+	// InkColor is the color of the press ripple. The zero value
+	// selects a translucent grey.
+	InkColor color.NRGBA
+	// InkDuration is the duration of the press ripple animation. A zero
+	// duration disables the ripple.
+	InkDuration time.Duration
+	// SoftInk fades out the edge of the press ripple.
+	SoftInk bool
+	// Icon is an optional icon drawn before the text.
+	Icon *widget.Icon
+	// IconSize is the size of Icon.
+	IconSize unit.Value
+	// Alignment is the alignment of the icon and text along the
+	// width of the button.
+	Alignment text.Alignment
+	Inset     layout.Inset
+	Button    *widget.Clickable
+	// Focusable is copied to Button.Focusable during Layout.
+	Focusable bool
+	// Disabled draws the button in a disabled state and ignores
+	// input, regardless of the state of the layout context.
+	Disabled bool
+	// DisabledColor is the color that the button fades towards when
+	// disabled.
+	DisabledColor color.NRGBA
+	// Description describes the button to accessibility services,
+	// in addition to Text.
+	Description string
+	shaper      text.Shaper
 }
 
 type ButtonLayoutStyle struct {
 	Background   color.NRGBA
 	CornerRadius unit.Value
-	Button       *widget.Clickable
+	// Corners overrides CornerRadius with individual corner radii,
+	// if any of them are non-zero.
+	Corners CornerRadii
+	// BackgroundGradient, if it has stops, replaces Background with
+	// a linear gradient.
+	BackgroundGradient Gradient
+	BorderColor        color.NRGBA
+	BorderWidth        unit.Value
+	Elevation          unit.Value
+	// PressedBackground, if set, replaces Background while the
+	// button is pressed.
+	PressedBackground color.NRGBA
+	FocusColor        color.NRGBA
+	InkColor          color.NRGBA
+	InkDuration       time.Duration
+	SoftInk           bool
+	Button            *widget.Clickable
+	// Focusable is copied to Button.Focusable during Layout.
+	Focusable bool
+	// Disabled draws the button in a disabled state and ignores
+	// input.
+	Disabled      bool
+	DisabledColor color.NRGBA
+	// Description describes the button to accessibility services.
+	Description string
+	// label is the text label of the button.
+	label string
+}
+
+// CornerRadii specify the radius of each corner of a rounded
+// rectangle.
+type CornerRadii struct {
+	SE, SW, NW, NE unit.Value
 }
 
 type IconButtonStyle struct {
@@ -44,9 +125,30 @@ type IconButtonStyle struct {
 	Color color.NRGBA
 	Icon  *widget.Icon
 	// Size is the icon size.
-	Size   unit.Value
-	Inset  layout.Inset
-	Button *widget.Clickable
+	Size unit.Value
+	// Text is an optional label laid out after the icon. A
+	// non-empty Text changes the button shape from a circle to
+	// a pill.
+	Text        string
+	Font        text.Font
+	TextSize    unit.Value
+	shaper      text.Shaper
+	InkColor    color.NRGBA
+	InkDuration time.Duration
+	// CenteredInk expands the press ripple from the center of the
+	// button instead of from the press position.
+	CenteredInk bool
+	Inset       layout.Inset
+	Button      *widget.Clickable
+	// Focusable is copied to Button.Focusable during Layout.
+	Focusable bool
+	// Disabled draws the button in a disabled state and ignores
+	// input.
+	Disabled      bool
+	DisabledColor color.NRGBA
+	// Description describes the button to accessibility services.
+	// Buttons without Text should have one.
+	Description string
 }
 
 func Button(th *Theme, button *widget.Clickable, txt string) ButtonStyle {
@@ -55,32 +157,83 @@ func Button(th *Theme, button *widget.Clickable, txt string) ButtonStyle {
 		Color:        th.Palette.ContrastFg,
 		CornerRadius: unit.Dp(4),
 		Background:   th.Palette.ContrastBg,
-		TextSize:     th.TextSize.Scale(14.0 / 16.0),
+		FocusColor:   th.Palette.ContrastFg,
+		Elevation:    unit.Dp(2),
+		TextSize:     th.TextStyleSize(TextStyleButton),
+		IconSize:     unit.Dp(18),
+		Alignment:    text.Middle,
 		Inset: layout.Inset{
 			Top: unit.Dp(10), Bottom: unit.Dp(10),
 			Left: unit.Dp(12), Right: unit.Dp(12),
 		},
-		Button: button,
-		shaper: th.Shaper,
+		InkDuration:   defaultInkDuration,
+		Button:        button,
+		Focusable:     true,
+		DisabledColor: th.Palette.Disabled,
+		shaper:        th.Shaper,
 	}
 }
 
+// OutlineButton returns a medium emphasis button with a transparent
+// background and an outline in the theme contrast color.
+func OutlineButton(th *Theme, button *widget.Clickable, txt string) ButtonStyle {
+	b := Button(th, button, txt)
+	b.Background = color.NRGBA{}
+	b.Color = th.Palette.ContrastBg
+	b.Elevation = unit.Value{}
+	b.FocusColor = th.Palette.ContrastBg
+	b.BorderColor = th.Palette.ContrastBg
+	b.BorderWidth = unit.Dp(1)
+	return b
+}
+
+// TextButton returns a low emphasis button with neither background nor
+// outline.
+func TextButton(th *Theme, button *widget.Clickable, txt string) ButtonStyle {
+	b := Button(th, button, txt)
+	b.Background = color.NRGBA{}
+	b.Color = th.Palette.ContrastBg
+	b.Elevation = unit.Value{}
+	b.FocusColor = th.Palette.ContrastBg
+	return b
+}
+
+// FilledTonalButton returns a button of emphasis between Button and
+// OutlineButton, filled with the theme secondary container color.
+func FilledTonalButton(th *Theme, button *widget.Clickable, txt string) ButtonStyle {
+	b := Button(th, button, txt)
+	b.Background = th.Palette.SecondaryContainer
+	b.Color = th.Palette.OnSecondaryContainer
+	b.Elevation = unit.Value{}
+	b.FocusColor = th.Palette.OnSecondaryContainer
+	return b
+}
+
 func ButtonLayout(th *Theme, button *widget.Clickable) ButtonLayoutStyle {
 	return ButtonLayoutStyle{
-		Button:       button,
-		Background:   th.Palette.ContrastBg,
-		CornerRadius: unit.Dp(4),
+		Button:        button,
+		Background:    th.Palette.ContrastBg,
+		CornerRadius:  unit.Dp(4),
+		FocusColor:    th.Palette.ContrastFg,
+		InkDuration:   defaultInkDuration,
+		Focusable:     true,
+		DisabledColor: th.Palette.Disabled,
 	}
 }
 
 func IconButton(th *Theme, button *widget.Clickable, icon *widget.Icon) IconButtonStyle {
 	return IconButtonStyle{
-		Background: th.Palette.ContrastBg,
-		Color:      th.Palette.ContrastFg,
-		Icon:       icon,
-		Size:       unit.Dp(24),
-		Inset:      layout.UniformInset(unit.Dp(12)),
-		Button:     button,
+		Background:    th.Palette.ContrastBg,
+		Color:         th.Palette.ContrastFg,
+		Icon:          icon,
+		Size:          unit.Dp(24),
+		TextSize:      th.TextStyleSize(TextStyleButton),
+		InkDuration:   defaultInkDuration,
+		Inset:         layout.UniformInset(unit.Dp(12)),
+		Button:        button,
+		Focusable:     true,
+		DisabledColor: th.Palette.Disabled,
+		shaper:        th.Shaper,
 	}
 }
 
@@ -91,9 +244,7 @@ func Clickable(gtx layout.Context, button *widget.Clickable, w layout.Widget) la
 		layout.Expanded(button.Layout),
 		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
 			clip.Rect{Max: gtx.Constraints.Min}.Add(gtx.Ops)
-			for _, c := range button.History() {
-				drawInk(gtx, c)
-			}
+			drawInks(gtx, button.History(), color.NRGBA{}, defaultInkDuration)
 			return layout.Dimensions{Size: gtx.Constraints.Min}
 		}),
 		layout.Stacked(w),
@@ -101,96 +252,287 @@ func Clickable(gtx layout.Context, button *widget.Clickable, w layout.Widget) la
 }
 
 func (b ButtonStyle) Layout(gtx layout.Context) layout.Dimensions {
+	min := gtx.Constraints.Min
 	return ButtonLayoutStyle{
-		Background:   b.Background,
-		CornerRadius: b.CornerRadius,
-		Button:       b.Button,
+		Background:         b.Background,
+		BackgroundGradient: b.BackgroundGradient,
+		CornerRadius:       b.CornerRadius,
+		Corners:            b.Corners,
+		BorderColor:        b.BorderColor,
+		BorderWidth:        b.BorderWidth,
+		Elevation:          b.Elevation,
+		PressedBackground:  b.PressedBackground,
+		FocusColor:         b.FocusColor,
+		InkColor:           b.InkColor,
+		InkDuration:        b.InkDuration,
+		SoftInk:            b.SoftInk,
+		Button:             b.Button,
+		Focusable:          b.Focusable,
+		Disabled:           b.Disabled,
+		DisabledColor:      b.DisabledColor,
+		Description:        b.Description,
+		label:              b.Text,
 	}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		if b.Icon != nil || b.Alignment != text.Middle {
+			// Fill the button width to align the content.
+			gtx.Constraints.Min.X = min.X
+		}
 		return b.Inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-			paint.ColorOp{Color: b.Color}.Add(gtx.Ops)
-			return widget.Label{Alignment: text.Middle}.Layout(gtx, b.shaper, b.Font, b.TextSize, b.Text)
+			col := blendDisabledColor(gtx.Queue == nil, b.DisabledColor, b.Color)
+			if b.Icon == nil && b.Alignment == text.Middle {
+				paint.ColorOp{Color: col}.Add(gtx.Ops)
+				return widget.Label{Alignment: text.Middle}.Layout(gtx, b.shaper, b.Font, b.TextSize, b.Text)
+			}
+			spacing := layout.SpaceSides
+			switch b.Alignment {
+			case text.Start:
+				spacing = layout.SpaceEnd
+			case text.End:
+				spacing = layout.SpaceStart
+			}
+			var children []layout.FlexChild
+			if b.Icon != nil {
+				children = append(children,
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						b.Icon.Color = col
+						return b.Icon.Layout(gtx, b.IconSize)
+					}),
+					layout.Rigid(layout.Spacer{Width: unit.Dp(8)}.Layout),
+				)
+			}
+			children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				paint.ColorOp{Color: col}.Add(gtx.Ops)
+				return widget.Label{Alignment: b.Alignment}.Layout(gtx, b.shaper, b.Font, b.TextSize, b.Text)
+			}))
+			return layout.Flex{Alignment: layout.Middle, Spacing: spacing}.Layout(gtx, children...)
 		})
 	})
 }
 
 func (b ButtonLayoutStyle) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
-	min := gtx.Constraints.Min
-	return layout.Stack{Alignment: layout.Center}.Layout(gtx,
-		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
-			rr := float32(gtx.Px(b.CornerRadius))
-			clip.UniformRRect(f32.Rectangle{Max: f32.Point{
-				X: float32(gtx.Constraints.Min.X),
-				Y: float32(gtx.Constraints.Min.Y),
-			}}, rr).Add(gtx.Ops)
-			background := b.Background
-			switch {
-			case gtx.Queue == nil:
-				background = f32color.Disabled(b.Background)
-			case b.Button.Hovered():
-				background = f32color.Hovered(b.Background)
-			}
-			paint.Fill(gtx.Ops, background)
-			for _, c := range b.Button.History() {
-				drawInk(gtx, c)
-			}
-			return layout.Dimensions{Size: gtx.Constraints.Min}
-		}),
-		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
-			gtx.Constraints.Min = min
-			return layout.Center.Layout(gtx, w)
-		}),
-		layout.Expanded(b.Button.Layout),
-	)
+	b.Button.Focusable = b.Focusable && !b.Disabled
+	if b.Disabled {
+		gtx = gtx.Disabled()
+	}
+	// Reserve room for the shadow within the dimensions of the button.
+	return ShadowInset(b.Elevation).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		min := gtx.Constraints.Min
+		dims := layout.Stack{Alignment: layout.Center}.Layout(gtx,
+			layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+				rr := b.rrect(gtx)
+				if gtx.Queue != nil {
+					drawShadow(gtx, rr, float32(gtx.Px(b.Elevation)), shadowBounds(gtx, gtx.Constraints.Min, b.Elevation))
+				}
+				rr.Add(gtx.Ops)
+				adjust := func(c color.NRGBA) color.NRGBA { return c }
+				switch {
+				case gtx.Queue == nil:
+					adjust = func(c color.NRGBA) color.NRGBA { return disabledColor(b.DisabledColor, c) }
+				case b.PressedBackground != (color.NRGBA{}) && pressed(b.Button):
+					adjust = func(color.NRGBA) color.NRGBA { return b.PressedBackground }
+				case b.Button.Hovered():
+					adjust = f32color.Hovered
+				}
+				if len(b.BackgroundGradient.Stops) > 0 {
+					b.BackgroundGradient.fill(gtx.Ops, layout.FPt(gtx.Constraints.Min), adjust)
+				} else {
+					paint.Fill(gtx.Ops, adjust(b.Background))
+				}
+				if !b.Disabled {
+					inkSet{color: b.InkColor, duration: b.InkDuration, soft: b.SoftInk}.draw(gtx, b.Button.History())
+				}
+				if w := float32(gtx.Px(b.BorderWidth)); w > 0 {
+					drawBorder(gtx, rr, w, blendDisabledColor(gtx.Queue == nil, b.DisabledColor, b.BorderColor))
+				}
+				if b.Button.Focused() && !b.Disabled {
+					drawBorder(gtx, rr, float32(gtx.Px(unit.Dp(2))), b.FocusColor)
+				}
+				return layout.Dimensions{Size: gtx.Constraints.Min}
+			}),
+			layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+				gtx.Constraints.Min = min
+				return layout.Center.Layout(gtx, w)
+			}),
+			layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+				if b.Disabled {
+					return layout.Dimensions{Size: gtx.Constraints.Min}
+				}
+				return b.Button.Layout(gtx)
+			}),
+		)
+		describe(gtx, dims.Size, description{class: semantic.Button, label: b.label, desc: b.Description})
+		return dims
+	})
 }
 
 func (b IconButtonStyle) Layout(gtx layout.Context) layout.Dimensions {
-	return layout.Stack{Alignment: layout.Center}.Layout(gtx,
+	b.Button.Focusable = b.Focusable && !b.Disabled
+	if b.Disabled {
+		gtx = gtx.Disabled()
+	}
+	dims := layout.Stack{Alignment: layout.Center}.Layout(gtx,
 		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
 			sizex, sizey := gtx.Constraints.Min.X, gtx.Constraints.Min.Y
 			sizexf, sizeyf := float32(sizex), float32(sizey)
-			rr := (sizexf + sizeyf) * .25
-			clip.UniformRRect(f32.Rectangle{
-				Max: f32.Point{X: sizexf, Y: sizeyf},
-			}, rr).Add(gtx.Ops)
+			bounds := f32.Rectangle{Max: f32.Point{X: sizexf, Y: sizeyf}}
+			if b.Text != "" {
+				clip.UniformRRect(bounds, sizeyf*.5).Add(gtx.Ops)
+			} else {
+				clip.Ellipse(bounds).Add(gtx.Ops)
+			}
 			background := b.Background
 			switch {
 			case gtx.Queue == nil:
-				background = f32color.Disabled(b.Background)
+				background = disabledColor(b.DisabledColor, b.Background)
 			case b.Button.Hovered():
 				background = f32color.Hovered(b.Background)
 			}
 			paint.Fill(gtx.Ops, background)
-			for _, c := range b.Button.History() {
-				drawInk(gtx, c)
+			if !b.Disabled {
+				inkSet{color: b.InkColor, duration: b.InkDuration, centered: b.CenteredInk}.draw(gtx, b.Button.History())
 			}
 			return layout.Dimensions{Size: gtx.Constraints.Min}
 		}),
 		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
 			return b.Inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-				size := gtx.Px(b.Size)
-				if b.Icon != nil {
-					b.Icon.Color = b.Color
-					b.Icon.Layout(gtx, unit.Px(float32(size)))
+				col := blendDisabledColor(gtx.Queue == nil, b.DisabledColor, b.Color)
+				icon := func(gtx layout.Context) layout.Dimensions {
+					size := gtx.Px(b.Size)
+					if b.Icon != nil {
+						b.Icon.Color = col
+						b.Icon.Layout(gtx, unit.Px(float32(size)))
+					}
+					return layout.Dimensions{
+						Size: image.Point{X: size, Y: size},
+					}
 				}
-				return layout.Dimensions{
-					Size: image.Point{X: size, Y: size},
+				if b.Text == "" {
+					return icon(gtx)
 				}
+				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+					layout.Rigid(icon),
+					layout.Rigid(layout.Spacer{Width: unit.Dp(8)}.Layout),
+					layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+						paint.ColorOp{Color: col}.Add(gtx.Ops)
+						return widget.Label{}.Layout(gtx, b.shaper, b.Font, b.TextSize, b.Text)
+					}),
+				)
 			})
 		}),
 		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
-			pointer.Ellipse(image.Rectangle{Max: gtx.Constraints.Min}).Add(gtx.Ops)
+			if b.Disabled {
+				return layout.Dimensions{Size: gtx.Constraints.Min}
+			}
+			if b.Text == "" {
+				pointer.Ellipse(image.Rectangle{Max: gtx.Constraints.Min}).Add(gtx.Ops)
+			}
 			return b.Button.Layout(gtx)
 		}),
 	)
+	describe(gtx, dims.Size, description{class: semantic.Button, label: b.Text, desc: b.Description})
+	return dims
+}
+
+// pressed reports whether button has a press in progress.
+func pressed(button *widget.Clickable) bool {
+	for _, p := range button.History() {
+		if p.End.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// rrect returns the background shape for the minimum constraints.
+func (b ButtonLayoutStyle) rrect(gtx layout.Context) clip.RRect {
+	c := b.Corners
+	if c == (CornerRadii{}) {
+		r := b.CornerRadius
+		c = CornerRadii{SE: r, SW: r, NW: r, NE: r}
+	}
+	return clip.RRect{
+		Rect: f32.Rectangle{Max: layout.FPt(gtx.Constraints.Min)},
+		SE:   float32(gtx.Px(c.SE)),
+		SW:   float32(gtx.Px(c.SW)),
+		NW:   float32(gtx.Px(c.NW)),
+		NE:   float32(gtx.Px(c.NE)),
+	}
+}
+
+// drawBorder strokes the inside edge of a rounded rectangle.
+func drawBorder(gtx layout.Context, rr clip.RRect, width float32, col color.NRGBA) {
+	half := width * .5
+	rr.Rect = f32.Rectangle{
+		Min: rr.Rect.Min.Add(f32.Pt(half, half)),
+		Max: rr.Rect.Max.Sub(f32.Pt(half, half)),
+	}
+	rr.SE = shrinkRadius(rr.SE, half)
+	rr.SW = shrinkRadius(rr.SW, half)
+	rr.NW = shrinkRadius(rr.NW, half)
+	rr.NE = shrinkRadius(rr.NE, half)
+	paint.FillShape(gtx.Ops, col,
+		clip.Stroke{
+			Path:  rr.Path(gtx.Ops),
+			Style: clip.StrokeStyle{Width: width},
+		}.Op(),
+	)
+}
+
+// shrinkRadius reduces a corner radius by d, clamping it at zero.
+func shrinkRadius(r, d float32) float32 {
+	if r -= d; r < 0 {
+		return 0
+	}
+	return r
+}
+
+// defaultInkDuration is the default duration of the press ripple.
+const defaultInkDuration = 900 * time.Millisecond
+
+// drawInks draws the ripples of presses. A single frame is requested
+// while any ripple animates, and none once they have all completed.
+func drawInks(gtx layout.Context, presses []widget.Press, ink color.NRGBA, duration time.Duration) {
+	inkSet{color: ink, duration: duration}.draw(gtx, presses)
 }
 
-func drawInk(gtx layout.Context, c widget.Press) {
-	// duration is the number of seconds for the
-	// completed animation: expand while fading in, then
-	// out.
-	const (
-		expandDuration = float32(0.5)
-		fadeDuration   = float32(0.9)
+// inkSet describes the ripples of a component.
+type inkSet struct {
+	// color is the ripple color. The zero value selects the
+	// default translucent grey.
+	color    color.NRGBA
+	duration time.Duration
+	// soft fades out the edge of the ripples.
+	soft bool
+	// centered expands the ripples from the center of the minimum
+	// constraints instead of from the press positions.
+	centered bool
+}
+
+func (s inkSet) draw(gtx layout.Context, presses []widget.Press) {
+	animating := false
+	for _, c := range presses {
+		if s.centered {
+			c.Position = layout.FPt(gtx.Constraints.Min).Mul(.5)
+		}
+		if drawInk(gtx, c, s.color, s.duration, s.soft) {
+			animating = true
+		}
+	}
+	if animating {
+		op.InvalidateOp{}.Add(gtx.Ops)
+	}
+}
+
+// drawInk draws the ripple for a press, and reports whether it is
+// animating. The ripple expands while fading in, then fades out,
+// during duration. If soft is set, the edge of the ripple fades out.
+func drawInk(gtx layout.Context, c widget.Press, ink color.NRGBA, duration time.Duration, soft bool) bool {
+	if duration <= 0 {
+		return false
+	}
+	var (
+		fadeDuration   = float32(duration.Seconds())
+		expandDuration = fadeDuration * 5 / 9
 	)
 
 	now := gtx.Now
@@ -229,7 +571,7 @@ func drawInk(gtx layout.Context, c widget.Press) {
 		half2 += haste
 		if half2 > 0.5 {
 			// Too old.
-			return
+			return false
 		}
 
 		alphat = half1 + half2
@@ -244,9 +586,7 @@ func drawInk(gtx layout.Context, c widget.Press) {
 	sizet /= expandDuration
 
 	// Animate only ended presses, and presses that are fading in.
-	if !c.End.IsZero() || sizet <= 1.0 {
-		op.InvalidateOp{}.Add(gtx.Ops)
-	}
+	animating := !c.End.IsZero() || sizet <= 1.0
 
 	if sizet > 1.0 {
 		sizet = 1.0
@@ -259,8 +599,8 @@ func drawInk(gtx layout.Context, c widget.Press) {
 	// Twice the speed to attain fully faded in at 0.5.
 	t2 := alphat * 2
 	// Beziér ease-in curve.
-	alphaBezier := t2 * t2 * (3.0 - 2.0*t2)
-	sizeBezier := sizet * sizet * (3.0 - 2.0*sizet)
+	alphaBezier := anim.Smoothstep(t2)
+	sizeBezier := anim.Smoothstep(sizet)
 	size := float32(gtx.Constraints.Min.X)
 	if h := float32(gtx.Constraints.Min.Y); h > size {
 		size = h
@@ -270,17 +610,27 @@ func drawInk(gtx layout.Context, c widget.Press) {
 	// Apply curve values to size and color.
 	size *= sizeBezier
 	alpha := 0.7 * alphaBezier
-	const col = 0.8
-	ba, bc := byte(alpha*0xff), byte(col*0xff)
+	if ink == (color.NRGBA{}) {
+		const col = 0.8
+		bc := byte(col * 0xff)
+		ink = color.NRGBA{A: 0xff, R: bc, G: bc, B: bc}
+	}
 	defer op.Save(gtx.Ops).Load()
-	rgba := f32color.MulAlpha(color.NRGBA{A: 0xff, R: bc, G: bc, B: bc}, ba)
-	ink := paint.ColorOp{Color: rgba}
-	ink.Add(gtx.Ops)
+	rgba := f32color.MulAlpha(ink, byte(alpha*0xff))
 	rr := size * .5
+	if soft {
+		clear := rgba
+		clear.A = 0
+		r := f32.Rectangle{Max: layout.FPt(gtx.Constraints.Min)}
+		paint.FillRadialGradient(gtx.Ops, r, c.Position, rr*.5, rr, rgba, clear)
+		return animating
+	}
+	paint.ColorOp{Color: rgba}.Add(gtx.Ops)
 	op.Offset(c.Position.Add(f32.Point{
 		X: -rr,
 		Y: -rr,
 	})).Add(gtx.Ops)
 	clip.UniformRRect(f32.Rectangle{Max: f32.Pt(size, size)}, rr).Add(gtx.Ops)
 	paint.PaintOp{}.Add(gtx.Ops)
+	return animating
 }
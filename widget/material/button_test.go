@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/font/gofont"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget"
+)
+
+func TestButtonIdleInvalidation(t *testing.T) {
+	var (
+		ops    op.Ops
+		r      router.Router
+		button widget.Clickable
+	)
+	th := NewTheme(gofont.Collection())
+	start := time.Unix(0, 0)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Now:         start,
+		Constraints: layout.Exact(image.Pt(100, 40)),
+	}
+	frame := func(e ...event.Event) bool {
+		r.Queue(e...)
+		ops.Reset()
+		Button(th, &button, "Button").Layout(gtx)
+		r.Frame(&ops)
+		_, invalidated := r.WakeupTime()
+		return invalidated
+	}
+	mouse := func(typ pointer.Type) pointer.Event {
+		return pointer.Event{
+			Type:     typ,
+			Source:   pointer.Mouse,
+			Buttons:  pointer.ButtonPrimary,
+			Position: f32.Pt(50, 20),
+		}
+	}
+	if frame() {
+		t.Error("idle button requested a frame")
+	}
+	frame(mouse(pointer.Press))
+	if !frame() {
+		t.Error("pressed button didn't animate its ripple")
+	}
+	// A held press doesn't animate once the ripple has expanded.
+	gtx.Now = start.Add(defaultInkDuration)
+	if frame() {
+		t.Error("held press requested a frame after its ripple expanded")
+	}
+	frame(mouse(pointer.Release))
+	if !frame() {
+		t.Error("released press didn't fade out its ripple")
+	}
+	// The completed press is still in the history, but no longer
+	// animates.
+	gtx.Now = gtx.Now.Add(defaultInkDuration * 3 / 4)
+	if len(button.History()) == 0 {
+		t.Fatal("no press in the button history")
+	}
+	if frame() {
+		t.Error("button with a completed ripple requested a frame")
+	}
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image/color"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+)
+
+// CardStyle draws a raised surface behind its content.
+type CardStyle struct {
+	Background   color.NRGBA
+	CornerRadius unit.Value
+	// Elevation is the height of the card above the surface it
+	// is drawn on, and determines the size of its shadow.
+	Elevation unit.Value
+	Inset     layout.Inset
+}
+
+func Card(th *Theme) CardStyle {
+	return CardStyle{
+		Background:   th.Palette.Surface,
+		CornerRadius: unit.Dp(4),
+		Elevation:    unit.Dp(2),
+		Inset:        layout.UniformInset(unit.Dp(16)),
+	}
+}
+
+// Layout lays out w inset on top of the card surface. The dimensions
+// include the room for the shadow around the surface.
+func (c CardStyle) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
+	return ShadowInset(c.Elevation).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Stack{}.Layout(gtx,
+			layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+				size := gtx.Constraints.Min
+				r := f32.Rectangle{Max: layout.FPt(size)}
+				rr := clip.UniformRRect(r, float32(gtx.Px(c.CornerRadius)))
+				drawShadow(gtx, rr, float32(gtx.Px(c.Elevation)), shadowBounds(gtx, size, c.Elevation))
+				paint.FillShape(gtx.Ops, c.Background, rr.Op(gtx.Ops))
+				return layout.Dimensions{Size: size}
+			}),
+			layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+				return c.Inset.Layout(gtx, w)
+			}),
+		)
+	})
+}
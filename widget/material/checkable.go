@@ -10,6 +10,7 @@ import (
 	"gioui.org/internal/f32color"
 	"gioui.org/io/pointer"
 	"gioui.org/layout"
+	"gioui.org/op"
 	"gioui.org/op/clip"
 	"gioui.org/op/paint"
 	"gioui.org/text"
@@ -18,25 +19,23 @@ import (
 )
 
 type checkable struct {
-	Label              string
-	Color              color.NRGBA
-	Font               text.Font
-	TextSize           unit.Value
-	IconColor          color.NRGBA
-	Size               unit.Value
-	shaper             text.Shaper
-	checkedStateIcon   *widget.Icon
-	uncheckedStateIcon *widget.Icon
+	Label         string
+	Color         color.NRGBA
+	Font          text.Font
+	TextSize      unit.Value
+	IconColor     color.NRGBA
+	DisabledColor color.NRGBA
+	Size          unit.Value
+	// Description describes the checkable to accessibility
+	// services, in addition to Label.
+	Description string
+	shaper      text.Shaper
 }
 
-func (c *checkable) layout(gtx layout.Context, checked, hovered bool) layout.Dimensions {
-	var icon *widget.Icon
-	if checked {
-		icon = c.checkedStateIcon
-	} else {
-		icon = c.uncheckedStateIcon
-	}
-
+// layout lays out the checkable with its label. The indicator draws
+// the state of the checkable in a square of Size. Presses in history
+// are drawn as an ink ripple around the indicator.
+func (c *checkable) layout(gtx layout.Context, hovered bool, history []widget.Press, indicator layout.Widget) layout.Dimensions {
 	dims := layout.Flex{Alignment: layout.Middle}.Layout(gtx,
 		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 			return layout.Stack{Alignment: layout.Center}.Layout(gtx,
@@ -45,32 +44,32 @@ func (c *checkable) layout(gtx layout.Context, checked, hovered bool) layout.Dim
 					dims := layout.Dimensions{
 						Size: image.Point{X: size, Y: size},
 					}
-					if !hovered {
-						return dims
-					}
-
-					background := f32color.MulAlpha(c.IconColor, 70)
-
 					radius := float32(size) / 2
-					paint.FillShape(gtx.Ops, background,
+					if hovered {
+						background := f32color.MulAlpha(c.IconColor, 70)
+						paint.FillShape(gtx.Ops, background,
+							clip.Circle{
+								Center: f32.Point{X: radius, Y: radius},
+								Radius: radius,
+							}.Op(gtx.Ops))
+					}
+					if gtx.Queue != nil && len(history) > 0 {
+						defer op.Save(gtx.Ops).Load()
 						clip.Circle{
 							Center: f32.Point{X: radius, Y: radius},
 							Radius: radius,
-						}.Op(gtx.Ops))
-
+						}.Add(gtx.Ops)
+						gtx.Constraints.Min = dims.Size
+						// Center the ripple around the indicator.
+						inkSet{duration: defaultInkDuration, centered: true}.draw(gtx, history)
+					}
 					return dims
 				}),
 				layout.Stacked(func(gtx layout.Context) layout.Dimensions {
 					return layout.UniformInset(unit.Dp(2)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
 						size := gtx.Px(c.Size)
-						icon.Color = c.IconColor
-						if gtx.Queue == nil {
-							icon.Color = f32color.Disabled(icon.Color)
-						}
-						icon.Layout(gtx, unit.Px(float32(size)))
-						return layout.Dimensions{
-							Size: image.Point{X: size, Y: size},
-						}
+						gtx.Constraints = layout.Exact(image.Pt(size, size))
+						return indicator(gtx)
 					})
 				}),
 			)
@@ -78,7 +77,7 @@ func (c *checkable) layout(gtx layout.Context, checked, hovered bool) layout.Dim
 
 		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
 			return layout.UniformInset(unit.Dp(2)).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
-				paint.ColorOp{Color: c.Color}.Add(gtx.Ops)
+				paint.ColorOp{Color: blendDisabledColor(gtx.Queue == nil, c.DisabledColor, c.Color)}.Add(gtx.Ops)
 				return widget.Label{}.Layout(gtx, c.shaper, c.Font, c.TextSize, c.Label)
 			})
 		}),
@@ -3,36 +3,128 @@
 package material
 
 import (
+	"image/color"
+	"math"
+
+	"gioui.org/f32"
+	"gioui.org/internal/f32color"
+	"gioui.org/io/semantic"
 	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
 	"gioui.org/unit"
 	"gioui.org/widget"
 )
 
 type CheckBoxStyle struct {
 	checkable
-	CheckBox *widget.Bool
+	// CheckColor is the color of the check mark.
+	CheckColor color.NRGBA
+	CheckBox   *widget.Bool
 }
 
 func CheckBox(th *Theme, checkBox *widget.Bool, label string) CheckBoxStyle {
 	return CheckBoxStyle{
-		CheckBox: checkBox,
+		CheckBox:   checkBox,
+		CheckColor: th.Palette.ContrastFg,
 		checkable: checkable{
-			Label:              label,
-			Color:              th.Palette.Fg,
-			IconColor:          th.Palette.ContrastBg,
-			TextSize:           th.TextSize.Scale(14.0 / 16.0),
-			Size:               unit.Dp(26),
-			shaper:             th.Shaper,
-			checkedStateIcon:   th.Icon.CheckBoxChecked,
-			uncheckedStateIcon: th.Icon.CheckBoxUnchecked,
+			Label:         label,
+			Color:         th.Palette.Fg,
+			IconColor:     th.Palette.ContrastBg,
+			DisabledColor: th.Palette.Disabled,
+			TextSize:      th.TextStyleSize(TextStyleBody2),
+			Size:          unit.Dp(26),
+			shaper:        th.Shaper,
 		},
 	}
 }
 
 // Layout updates the checkBox and displays it.
 func (c CheckBoxStyle) Layout(gtx layout.Context) layout.Dimensions {
-	dims := c.layout(gtx, c.CheckBox.Value, c.CheckBox.Hovered())
+	dims := c.layout(gtx, c.CheckBox.Hovered() || c.CheckBox.Focused(), c.CheckBox.History(), c.drawBox)
 	gtx.Constraints.Min = dims.Size
 	c.CheckBox.Layout(gtx)
+	describe(gtx, dims.Size, description{
+		class:    semantic.CheckBox,
+		label:    c.Label,
+		desc:     c.Description,
+		selected: c.CheckBox.Value,
+	})
 	return dims
 }
+
+// drawBox draws the box and, when checked, the check mark stroked in
+// from its start.
+func (c CheckBoxStyle) drawBox(gtx layout.Context) layout.Dimensions {
+	size := gtx.Constraints.Min
+	// The box and check mark are laid out on a grid of 24 units,
+	// matching the material check box icons.
+	scale := float32(size.X) / 24
+	progress := float32(1)
+	if dt := gtx.Now.Sub(c.CheckBox.ChangeTime()); dt < toggleDuration {
+		progress = float32(dt.Seconds() / toggleDuration.Seconds())
+		op.InvalidateOp{}.Add(gtx.Ops)
+	}
+	disabled := gtx.Queue == nil
+	col := blendDisabledColor(disabled, c.DisabledColor, c.IconColor)
+	checkCol := blendDisabledColor(disabled, c.DisabledColor, c.CheckColor)
+	box := f32.Rectangle{
+		Min: f32.Pt(3*scale, 3*scale),
+		Max: f32.Pt(21*scale, 21*scale),
+	}
+	rr := 2 * scale
+	fill := progress
+	if !c.CheckBox.Value {
+		fill = 1 - progress
+	}
+	if fill < 1 {
+		drawBorder(gtx, clip.UniformRRect(box, rr), 2*scale, col)
+	}
+	if fill > 0 {
+		paint.FillShape(gtx.Ops, f32color.MulAlpha(col, uint8(fill*0xff)), clip.UniformRRect(box, rr).Op(gtx.Ops))
+	}
+	if c.CheckBox.Value {
+		drawCheckMark(gtx.Ops, scale, progress, checkCol)
+	}
+	return layout.Dimensions{Size: size}
+}
+
+// drawCheckMark strokes the given fraction of a check mark scaled
+// from a grid of 24 units.
+func drawCheckMark(ops *op.Ops, scale, fraction float32, col color.NRGBA) {
+	pts := [...]f32.Point{{X: 6.5, Y: 12.5}, {X: 10, Y: 16}, {X: 17.5, Y: 8.5}}
+	var length float32
+	for i := 1; i < len(pts); i++ {
+		length += dist(pts[i-1], pts[i])
+	}
+	remaining := length * fraction
+	defer op.Save(ops).Load()
+	var p clip.Path
+	p.Begin(ops)
+	p.MoveTo(pts[0].Mul(scale))
+	for i := 1; i < len(pts) && remaining > 0; i++ {
+		from, to := pts[i-1], pts[i]
+		d := dist(from, to)
+		if d > remaining {
+			to = from.Add(to.Sub(from).Mul(remaining / d))
+		}
+		remaining -= d
+		p.LineTo(to.Mul(scale))
+	}
+	clip.Stroke{
+		Path: p.End(),
+		Style: clip.StrokeStyle{
+			Width: 2 * scale,
+			Cap:   clip.FlatCap,
+			Join:  clip.BevelJoin,
+		},
+	}.Op().Add(ops)
+	paint.ColorOp{Color: col}.Add(ops)
+	paint.PaintOp{}.Add(ops)
+}
+
+func dist(a, b f32.Point) float32 {
+	d := b.Sub(a)
+	return float32(math.Hypot(float64(d.X), float64(d.Y)))
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image/color"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/internal/f32color"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// ChipStyle defines the presentation of a compact pill shaped element
+// with a label, an optional leading icon and an optional trailing
+// close button.
+type ChipStyle struct {
+	Text string
+	// Color is the text and icon color.
+	Color      color.NRGBA
+	Font       text.Font
+	TextSize   unit.Value
+	Background color.NRGBA
+	// DisabledColor is the color the chip fades towards while
+	// disabled.
+	DisabledColor color.NRGBA
+	// Icon is an optional icon drawn before the text.
+	Icon *widget.Icon
+	// IconSize is the size of Icon and CloseIcon.
+	IconSize unit.Value
+	// CloseIcon is the icon of the close button.
+	CloseIcon   *widget.Icon
+	InkColor    color.NRGBA
+	InkDuration time.Duration
+	Inset       layout.Inset
+	// Button is the state of the chip body. A nil Button makes the
+	// chip not clickable.
+	Button *widget.Clickable
+	// Close is the state of the close button. A nil Close hides
+	// the button.
+	Close  *widget.Clickable
+	shaper text.Shaper
+}
+
+// Chip returns a chip with a label. Either button or the Close field
+// may be nil.
+func Chip(th *Theme, button *widget.Clickable, txt string) ChipStyle {
+	return ChipStyle{
+		Text:          txt,
+		Color:         th.Palette.OnSecondaryContainer,
+		TextSize:      th.TextStyleSize(TextStyleBody2),
+		Background:    th.Palette.SecondaryContainer,
+		DisabledColor: th.Palette.Disabled,
+		IconSize:      unit.Dp(18),
+		CloseIcon:     th.Icon.Close,
+		InkDuration:   defaultInkDuration,
+		Inset: layout.Inset{
+			Top: unit.Dp(7), Bottom: unit.Dp(7),
+			Left: unit.Dp(12), Right: unit.Dp(12),
+		},
+		Button: button,
+		shaper: th.Shaper,
+	}
+}
+
+func (c ChipStyle) Layout(gtx layout.Context) layout.Dimensions {
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			defer op.Save(gtx.Ops).Load()
+			size := layout.FPt(gtx.Constraints.Min)
+			clip.UniformRRect(f32.Rectangle{Max: size}, size.Y*.5).Add(gtx.Ops)
+			background := c.Background
+			switch {
+			case gtx.Queue == nil:
+				background = disabledColor(c.DisabledColor, c.Background)
+			case c.Button != nil && c.Button.Hovered():
+				background = f32color.Hovered(c.Background)
+			}
+			paint.Fill(gtx.Ops, background)
+			if c.Button == nil {
+				return layout.Dimensions{Size: gtx.Constraints.Min}
+			}
+			drawInks(gtx, c.Button.History(), c.InkColor, c.InkDuration)
+			return c.Button.Layout(gtx)
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			col := blendDisabledColor(gtx.Queue == nil, c.DisabledColor, c.Color)
+			inset := c.Inset
+			if c.Icon != nil {
+				inset.Left = unit.Dp(8)
+			}
+			if c.Close != nil {
+				inset.Right = unit.Dp(8)
+			}
+			return inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				var children []layout.FlexChild
+				if c.Icon != nil {
+					children = append(children,
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							c.Icon.Color = col
+							return c.Icon.Layout(gtx, c.IconSize)
+						}),
+						layout.Rigid(layout.Spacer{Width: unit.Dp(8)}.Layout),
+					)
+				}
+				children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					paint.ColorOp{Color: col}.Add(gtx.Ops)
+					return widget.Label{MaxLines: 1}.Layout(gtx, c.shaper, c.Font, c.TextSize, c.Text)
+				}))
+				if c.Close != nil && c.CloseIcon != nil {
+					children = append(children,
+						layout.Rigid(layout.Spacer{Width: unit.Dp(8)}.Layout),
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							return layout.Stack{}.Layout(gtx,
+								layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+									c.CloseIcon.Color = col
+									return c.CloseIcon.Layout(gtx, c.IconSize)
+								}),
+								layout.Expanded(c.Close.Layout),
+							)
+						}),
+					)
+				}
+				return layout.Flex{Alignment: layout.Middle}.Layout(gtx, children...)
+			})
+		}),
+	)
+}
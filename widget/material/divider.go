@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/internal/f32color"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+)
+
+// DividerStyle defines the presentation of a thin line separating
+// content.
+type DividerStyle struct {
+	Color     color.NRGBA
+	Thickness unit.Value
+	// Inset is the space around the line, for example to indent
+	// the line from the start.
+	Inset layout.Inset
+	// Axis is the direction of the line. Horizontal dividers fill
+	// the maximum width, vertical dividers the maximum height.
+	Axis layout.Axis
+}
+
+// Divider returns a horizontal divider.
+func Divider(th *Theme) DividerStyle {
+	return DividerStyle{
+		Color:     f32color.MulAlpha(th.Palette.Outline, 0x40),
+		Thickness: unit.Dp(1),
+	}
+}
+
+func (d DividerStyle) Layout(gtx layout.Context) layout.Dimensions {
+	return d.Inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		length := d.Axis.Convert(gtx.Constraints.Max).X
+		size := d.Axis.Convert(image.Pt(length, gtx.Px(d.Thickness)))
+		size = gtx.Constraints.Constrain(size)
+		paint.FillShape(gtx.Ops, d.Color, clip.Rect{Max: size}.Op())
+		return layout.Dimensions{Size: size}
+	})
+}
@@ -36,7 +36,12 @@
 // Theme-global parameters: For changing the look of all widgets drawn with a
 // particular theme, adjust the `Theme` fields:
 //
-//     theme.Color.Primary = color.NRGBA{...}
+//     theme.Palette.ContrastBg = color.NRGBA{...}
+//
+// To switch the whole theme, for example to dark mode, replace its
+// palette:
+//
+//     theme.Palette = material.DarkPalette
 //
 // Widget-local parameters: For changing the look of a particular widget,
 // adjust the widget specific theme object:
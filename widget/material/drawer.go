@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/f32"
+	"gioui.org/internal/f32color"
+	"gioui.org/io/semantic"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// DrawerStyle defines the presentation of a navigation drawer with a
+// list of destinations. The drawer is a modal sheet over the content,
+// or permanently beside it when there is room.
+type DrawerStyle struct {
+	Drawer *widget.Drawer
+	Items  []NavItem
+	// Width is the width of the sheet.
+	Width unit.Value
+	// PermanentWidth is the minimum available width for showing the
+	// drawer permanently beside the content. Zero disables the
+	// permanent drawer.
+	PermanentWidth unit.Value
+	Background     color.NRGBA
+	// Color is the icon and label color of unselected destinations.
+	Color color.NRGBA
+	// SelectedColor is the icon and label color of the selected
+	// destination.
+	SelectedColor color.NRGBA
+	// SelectedBackground fills the selected destination.
+	SelectedBackground color.NRGBA
+	ScrimColor         color.NRGBA
+	DisabledColor      color.NRGBA
+	// Elevation is the height of the modal sheet above the content.
+	Elevation unit.Value
+	IconSize  unit.Value
+	Font      text.Font
+	TextSize  unit.Value
+	InkColor  color.NRGBA
+	shaper    text.Shaper
+}
+
+// Drawer returns a navigation drawer for the destinations items.
+func Drawer(th *Theme, state *widget.Drawer, items ...NavItem) DrawerStyle {
+	return DrawerStyle{
+		Drawer:             state,
+		Items:              items,
+		Width:              unit.Dp(256),
+		PermanentWidth:     unit.Dp(840),
+		Background:         th.Palette.Surface,
+		Color:              f32color.MulAlpha(th.Palette.OnSurface, 0xde),
+		SelectedColor:      th.Palette.ContrastBg,
+		SelectedBackground: f32color.MulAlpha(th.Palette.ContrastBg, 0x1f),
+		ScrimColor:         argb(0x80000000),
+		DisabledColor:      th.Palette.Disabled,
+		Elevation:          unit.Dp(16),
+		IconSize:           unit.Dp(24),
+		TextSize:           th.TextStyleSize(TextStyleSubtitle2),
+		shaper:             th.Shaper,
+	}
+}
+
+// Permanent reports whether the drawer is shown beside the content in
+// the maximum constraints.
+func (d DrawerStyle) Permanent(gtx layout.Context) bool {
+	return d.PermanentWidth.V > 0 && gtx.Constraints.Max.X >= gtx.Px(d.PermanentWidth)
+}
+
+// Layout content over the maximum constraints, with the permanent
+// drawer before it or the modal drawer on top of it.
+func (d DrawerStyle) Layout(gtx layout.Context, content layout.Widget) layout.Dimensions {
+	size := gtx.Constraints.Max
+	gtx.Constraints.Min = size
+	if d.Permanent(gtx) {
+		return layout.Flex{}.Layout(gtx,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return d.layoutSheet(gtx, true)
+			}),
+			layout.Flexed(1, content),
+		)
+	}
+	stack := op.Save(gtx.Ops)
+	content(gtx)
+	stack.Load()
+	state := d.Drawer
+	return state.Layout(gtx,
+		func(gtx layout.Context) layout.Dimensions {
+			scrim := f32color.MulAlpha(d.ScrimColor, uint8(state.Position()*0xff))
+			paint.FillShape(gtx.Ops, scrim, clip.Rect{Max: gtx.Constraints.Min}.Op())
+			return layout.Dimensions{Size: gtx.Constraints.Min}
+		},
+		func(gtx layout.Context) layout.Dimensions {
+			return d.layoutSheet(gtx, false)
+		},
+	)
+}
+
+// layoutSheet lays out the background and destinations of the sheet.
+// Choosing a destination closes a modal drawer.
+func (d DrawerStyle) layoutSheet(gtx layout.Context, permanent bool) layout.Dimensions {
+	state := d.Drawer
+	width := gtx.Px(d.Width)
+	if !permanent {
+		// Leave room for closing the drawer by the scrim.
+		if max := gtx.Constraints.Max.X - gtx.Px(unit.Dp(56)); width > max {
+			width = max
+		}
+	}
+	if width < 0 {
+		width = 0
+	}
+	size := image.Pt(width, gtx.Constraints.Max.Y)
+	// The shadow of the modal sheet falls on the content, within the
+	// maximum constraints.
+	bounds := image.Rectangle{Max: gtx.Constraints.Max}
+	gtx.Constraints = layout.Exact(size)
+	if !permanent {
+		r := clip.RRect{Rect: f32.Rectangle{Max: layout.FPt(size)}}
+		drawShadow(gtx, r, float32(gtx.Px(d.Elevation)), bounds)
+	}
+	paint.FillShape(gtx.Ops, blendDisabledColor(gtx.Queue == nil, d.DisabledColor, d.Background), clip.Rect{Max: size}.Op())
+	prev := state.Nav.Value
+	state.List.Axis = layout.Vertical
+	layout.Inset{Top: unit.Dp(8), Bottom: unit.Dp(8), Left: unit.Dp(8), Right: unit.Dp(8)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return state.List.Layout(gtx, len(d.Items), func(gtx layout.Context, i int) layout.Dimensions {
+			return d.layoutItem(gtx, d.Items[i])
+		})
+	})
+	if !permanent && state.Nav.Value != prev {
+		state.Close()
+	}
+	return layout.Dimensions{Size: size}
+}
+
+// layoutItem lays out the icon, label, background and input handler
+// of a destination.
+func (d DrawerStyle) layoutItem(gtx layout.Context, item NavItem) layout.Dimensions {
+	state := &d.Drawer.Nav
+	selected := state.Value == item.Key
+	col := d.Color
+	if selected {
+		col = d.SelectedColor
+	}
+	col = blendDisabledColor(gtx.Queue == nil, d.DisabledColor, col)
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	dims := layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			defer op.Save(gtx.Ops).Load()
+			rr := float32(gtx.Px(unit.Dp(4)))
+			clip.UniformRRect(f32.Rectangle{Max: layout.FPt(gtx.Constraints.Min)}, rr).Add(gtx.Ops)
+			if selected {
+				paint.Fill(gtx.Ops, blendDisabledColor(gtx.Queue == nil, d.DisabledColor, d.SelectedBackground))
+			}
+			if key, ok := state.Hovered(); ok && key == item.Key && gtx.Queue != nil {
+				paint.Fill(gtx.Ops, f32color.MulAlpha(d.Color, 0x14))
+			}
+			drawInks(gtx, state.History(item.Key), d.InkColor, defaultInkDuration)
+			return state.Layout(gtx, item.Key)
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			in := layout.Inset{
+				Top: unit.Dp(12), Bottom: unit.Dp(12),
+				Left: unit.Dp(16), Right: unit.Dp(16),
+			}
+			return in.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				var children []layout.FlexChild
+				if item.Icon != nil {
+					children = append(children,
+						layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+							item.Icon.Color = col
+							return item.Icon.Layout(gtx, d.IconSize)
+						}),
+						layout.Rigid(layout.Spacer{Width: unit.Dp(32)}.Layout),
+					)
+				}
+				children = append(children, layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					paint.ColorOp{Color: col}.Add(gtx.Ops)
+					return widget.Label{MaxLines: 1}.Layout(gtx, d.shaper, d.Font, d.TextSize, item.Label)
+				}))
+				return layout.Flex{Alignment: layout.Middle}.Layout(gtx, children...)
+			})
+		}),
+	)
+	describe(gtx, dims.Size, description{class: semantic.RadioButton, label: item.Label, selected: selected})
+	return dims
+}
@@ -6,12 +6,16 @@ import (
 	"image/color"
 
 	"gioui.org/internal/f32color"
+	"gioui.org/io/key"
+	"gioui.org/io/semantic"
 	"gioui.org/layout"
 	"gioui.org/op"
 	"gioui.org/op/paint"
 	"gioui.org/text"
 	"gioui.org/unit"
 	"gioui.org/widget"
+
+	"golang.org/x/image/math/fixed"
 )
 
 type EditorStyle struct {
@@ -25,28 +29,53 @@ type EditorStyle struct {
 	HintColor color.NRGBA
 	// SelectionColor is the color of the background for selected text.
 	SelectionColor color.NRGBA
-	Editor         *widget.Editor
+	// Mask replaces the display of each rune with the given rune,
+	// for example to hide passwords. A zero Mask shows the text.
+	// Mask is copied to Editor.Mask during Layout.
+	Mask rune
+	// MinLines is the minimum height of the editor in lines of text.
+	MinLines int
+	// MaxLines, if positive, is the maximum height of the editor in
+	// lines of text. Longer text is scrolled.
+	MaxLines int
+	// InputHint selects the on-screen keyboard. InputHint is
+	// copied to Editor.InputHint during Layout.
+	InputHint key.InputHint
+	// DisabledColor is the color that the editor fades towards when
+	// disabled.
+	DisabledColor color.NRGBA
+	Editor        *widget.Editor
+	// Description describes the editor to accessibility services,
+	// in addition to Hint.
+	Description string
 
+	// label overrides Hint as the label of the editor.
+	label  string
 	shaper text.Shaper
 }
 
 func Editor(th *Theme, editor *widget.Editor, hint string) EditorStyle {
 	return EditorStyle{
 		Editor:         editor,
+		Mask:           editor.Mask,
+		InputHint:      editor.InputHint,
 		TextSize:       th.TextSize,
 		Color:          th.Palette.Fg,
 		shaper:         th.Shaper,
 		Hint:           hint,
 		HintColor:      f32color.MulAlpha(th.Palette.Fg, 0xbb),
 		SelectionColor: f32color.MulAlpha(th.Palette.ContrastBg, 0x60),
+		DisabledColor:  th.Palette.Disabled,
 	}
 }
 
 func (e EditorStyle) Layout(gtx layout.Context) layout.Dimensions {
 	defer op.Save(gtx.Ops).Load()
+	e.Editor.Mask = e.Mask
+	e.Editor.InputHint = e.InputHint
 	macro := op.Record(gtx.Ops)
-	paint.ColorOp{Color: e.HintColor}.Add(gtx.Ops)
-	var maxlines int
+	paint.ColorOp{Color: blendDisabledColor(gtx.Queue == nil, e.DisabledColor, e.HintColor)}.Add(gtx.Ops)
+	maxlines := e.MaxLines
 	if e.Editor.SingleLine {
 		maxlines = 1
 	}
@@ -59,12 +88,22 @@ func (e EditorStyle) Layout(gtx layout.Context) layout.Dimensions {
 	if h := dims.Size.Y; gtx.Constraints.Min.Y < h {
 		gtx.Constraints.Min.Y = h
 	}
+	if e.MinLines > 0 || e.MaxLines > 0 {
+		lh := e.shaper.Metrics(e.Font, fixed.I(gtx.Px(e.TextSize))).LineHeight()
+		if h := (lh * fixed.Int26_6(e.MinLines)).Ceil(); gtx.Constraints.Min.Y < h {
+			gtx.Constraints.Min.Y = h
+		}
+		if h := (lh * fixed.Int26_6(e.MaxLines)).Ceil(); e.MaxLines > 0 && gtx.Constraints.Max.Y > h {
+			gtx.Constraints.Max.Y = h
+		}
+		gtx.Constraints.Min = gtx.Constraints.Constrain(gtx.Constraints.Min)
+	}
 	dims = e.Editor.Layout(gtx, e.shaper, e.Font, e.TextSize)
 	disabled := gtx.Queue == nil
 	if e.Editor.Len() > 0 {
-		paint.ColorOp{Color: blendDisabledColor(disabled, e.SelectionColor)}.Add(gtx.Ops)
+		paint.ColorOp{Color: blendDisabledColor(disabled, e.DisabledColor, e.SelectionColor)}.Add(gtx.Ops)
 		e.Editor.PaintSelection(gtx)
-		paint.ColorOp{Color: blendDisabledColor(disabled, e.Color)}.Add(gtx.Ops)
+		paint.ColorOp{Color: blendDisabledColor(disabled, e.DisabledColor, e.Color)}.Add(gtx.Ops)
 		e.Editor.PaintText(gtx)
 	} else {
 		call.Add(gtx.Ops)
@@ -73,12 +112,35 @@ func (e EditorStyle) Layout(gtx layout.Context) layout.Dimensions {
 		paint.ColorOp{Color: e.Color}.Add(gtx.Ops)
 		e.Editor.PaintCaret(gtx)
 	}
+	d := description{
+		class: semantic.Editor,
+		label: e.Hint,
+		desc:  e.Description,
+	}
+	if e.label != "" {
+		d.label = e.label
+	}
+	if e.Mask == 0 {
+		d.value = e.Editor.Text()
+	}
+	describe(gtx, dims.Size, d)
 	return dims
 }
 
-func blendDisabledColor(disabled bool, c color.NRGBA) color.NRGBA {
+func blendDisabledColor(disabled bool, d, c color.NRGBA) color.NRGBA {
 	if disabled {
-		return f32color.Disabled(c)
+		return disabledColor(d, c)
 	}
 	return c
 }
+
+// disabledColor blends c towards d, the disabled color of a palette,
+// and fades it into the background. A zero d desaturates c instead.
+func disabledColor(d, c color.NRGBA) color.NRGBA {
+	if d == (color.NRGBA{}) {
+		return f32color.Disabled(c)
+	}
+	m := f32color.Lerp(c, d, .7)
+	m.A = byte(int(c.A) * (128 + 32) / 256)
+	return m
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"gioui.org/f32"
+	"gioui.org/internal/f32color"
+	"gioui.org/io/semantic"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// ExpanderStyle defines the presentation of a collapsible section
+// with a title and a chevron that turns when the section expands.
+type ExpanderStyle struct {
+	Title string
+	// Color is the title color.
+	Color         color.NRGBA
+	IconColor     color.NRGBA
+	DisabledColor color.NRGBA
+	Font          text.Font
+	TextSize      unit.Value
+	IconSize      unit.Value
+	InkColor      color.NRGBA
+	// Inset is the space around the header content.
+	Inset    layout.Inset
+	Icon     *widget.Icon
+	Expander *widget.Expander
+	shaper   text.Shaper
+}
+
+// Expander returns a collapsible section titled title.
+func Expander(th *Theme, state *widget.Expander, title string) ExpanderStyle {
+	return ExpanderStyle{
+		Title:         title,
+		Color:         th.Palette.Fg,
+		IconColor:     f32color.MulAlpha(th.Palette.Fg, 0xbb),
+		DisabledColor: th.Palette.Disabled,
+		TextSize:      th.TextStyleSize(TextStyleSubtitle1),
+		IconSize:      unit.Dp(24),
+		Inset: layout.Inset{
+			Top: unit.Dp(12), Bottom: unit.Dp(12),
+			Left: unit.Dp(16), Right: unit.Dp(16),
+		},
+		Icon:     th.Icon.ExpandMore,
+		Expander: state,
+		shaper:   th.Shaper,
+	}
+}
+
+// Layout the header over the maximum width, and body below it while
+// the section is expanded.
+func (e ExpanderStyle) Layout(gtx layout.Context, body layout.Widget) layout.Dimensions {
+	return e.Expander.Layout(gtx, e.layoutHeader, body)
+}
+
+func (e ExpanderStyle) layoutHeader(gtx layout.Context) layout.Dimensions {
+	state := e.Expander
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	dims := layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			defer op.Save(gtx.Ops).Load()
+			clip.Rect{Max: gtx.Constraints.Min}.Add(gtx.Ops)
+			if state.Header.Hovered() && gtx.Queue != nil {
+				paint.Fill(gtx.Ops, f32color.MulAlpha(e.Color, 0x14))
+			}
+			drawInks(gtx, state.Header.History(), e.InkColor, defaultInkDuration)
+			return layout.Dimensions{Size: gtx.Constraints.Min}
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			return e.Inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+					layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+						paint.ColorOp{Color: blendDisabledColor(gtx.Queue == nil, e.DisabledColor, e.Color)}.Add(gtx.Ops)
+						return widget.Label{}.Layout(gtx, e.shaper, e.Font, e.TextSize, e.Title)
+					}),
+					layout.Rigid(e.layoutIcon),
+				)
+			})
+		}),
+	)
+	describe(gtx, dims.Size, description{class: semantic.Button, label: e.Title})
+	return dims
+}
+
+// layoutIcon lays out the chevron, turned half a revolution by the
+// transition to the expanded state.
+func (e ExpanderStyle) layoutIcon(gtx layout.Context) layout.Dimensions {
+	size := gtx.Px(e.IconSize)
+	dims := layout.Dimensions{Size: image.Pt(size, size)}
+	if e.Icon == nil {
+		return dims
+	}
+	defer op.Save(gtx.Ops).Load()
+	center := layout.FPt(dims.Size).Mul(.5)
+	angle := float32(math.Pi) * e.Expander.Progress()
+	op.Affine(f32.Affine2D{}.Rotate(center, angle)).Add(gtx.Ops)
+	e.Icon.Color = blendDisabledColor(gtx.Queue == nil, e.DisabledColor, e.IconColor)
+	e.Icon.Layout(gtx, unit.Px(float32(size)))
+	return dims
+}
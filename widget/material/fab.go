@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// FabStyle defines the presentation of a floating action button, a
+// raised circular icon button for the primary action of a screen.
+type FabStyle struct {
+	IconButtonStyle
+	// Elevation is the height of the resting button above the
+	// surface.
+	Elevation unit.Value
+	// PressedElevation is the height of the button while pressed.
+	PressedElevation unit.Value
+	// Margin is the space between the button and the edges of its
+	// parent in LayoutAnchored.
+	Margin unit.Value
+}
+
+// ExtendedFabStyle defines the presentation of a floating action
+// button with a label after its icon.
+type ExtendedFabStyle struct {
+	FabStyle
+}
+
+// Fab returns a 56dp floating action button in the theme secondary
+// color. The description is necessary for accessibility, because the
+// button has no text.
+func Fab(th *Theme, button *widget.Clickable, icon *widget.Icon, description string) FabStyle {
+	b := IconButton(th, button, icon)
+	b.Background = th.Palette.Secondary
+	b.Color = th.Palette.OnSecondary
+	b.Inset = layout.UniformInset(unit.Dp(16))
+	b.CenteredInk = true
+	b.Description = description
+	return FabStyle{
+		IconButtonStyle:  b,
+		Elevation:        unit.Dp(6),
+		PressedElevation: unit.Dp(12),
+		Margin:           unit.Dp(16),
+	}
+}
+
+// ExtendedFab returns a 48dp high floating action button with an icon
+// and a label.
+func ExtendedFab(th *Theme, button *widget.Clickable, icon *widget.Icon, txt string) ExtendedFabStyle {
+	f := Fab(th, button, icon, "")
+	f.Text = txt
+	f.CenteredInk = false
+	f.Inset = layout.Inset{
+		Top: unit.Dp(12), Bottom: unit.Dp(12),
+		Left: unit.Dp(12), Right: unit.Dp(20),
+	}
+	return ExtendedFabStyle{FabStyle: f}
+}
+
+// Layout the button above its shadow.
+func (f FabStyle) Layout(gtx layout.Context) layout.Dimensions {
+	// Reserve room for the shadow of the higher elevation.
+	room := f.Elevation
+	if gtx.Px(f.PressedElevation) > gtx.Px(room) {
+		room = f.PressedElevation
+	}
+	return ShadowInset(room).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		macro := op.Record(gtx.Ops)
+		dims := f.IconButtonStyle.Layout(gtx)
+		call := macro.Stop()
+		elevation := f.Elevation
+		if pressed(f.Button) {
+			elevation = f.PressedElevation
+		}
+		if gtx.Queue != nil && !f.Disabled {
+			r := f32.Rectangle{Max: layout.FPt(dims.Size)}
+			bounds := shadowBounds(gtx, dims.Size, room)
+			drawShadow(gtx, clip.UniformRRect(r, r.Dy()*.5), float32(gtx.Px(elevation)), bounds)
+		}
+		call.Add(gtx.Ops)
+		return dims
+	})
+}
+
+// LayoutAnchored lays out the button at the bottom end of the maximum
+// constraints, Margin from the edges.
+func (f FabStyle) LayoutAnchored(gtx layout.Context) layout.Dimensions {
+	gtx.Constraints.Min = gtx.Constraints.Max
+	return layout.UniformInset(f.Margin).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.SE.Layout(gtx, f.Layout)
+	})
+}
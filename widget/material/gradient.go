@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image/color"
+
+	"gioui.org/f32"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+)
+
+// Gradient describes a linear gradient relative to the area it fills,
+// where (0, 0) is the top left and (1, 1) the bottom right corner.
+type Gradient struct {
+	Start, End f32.Point
+	Stops      []paint.GradientStop
+}
+
+// Fill paints the gradient over a rectangle of size, within the
+// current clip.
+func (g Gradient) Fill(ops *op.Ops, size f32.Point) {
+	g.fill(ops, size, nil)
+}
+
+// fill is like Fill, and applies adjust, if not nil, to the colors
+// of the stops.
+func (g Gradient) fill(ops *op.Ops, size f32.Point, adjust func(color.NRGBA) color.NRGBA) {
+	stops := g.Stops
+	if adjust != nil {
+		stops = make([]paint.GradientStop, len(g.Stops))
+		for i, s := range g.Stops {
+			stops[i] = paint.GradientStop{Offset: s.Offset, Color: adjust(s.Color)}
+		}
+	}
+	rel := func(p f32.Point) f32.Point {
+		return f32.Pt(p.X*size.X, p.Y*size.Y)
+	}
+	paint.FillLinearGradient(ops, f32.Rectangle{Max: size}, rel(g.Start), rel(g.End), stops...)
+}
@@ -21,46 +21,77 @@ type LabelStyle struct {
 	Alignment text.Alignment
 	// MaxLines limits the number of lines. Zero means no limit.
 	MaxLines int
-	Text     string
-	TextSize unit.Value
+	// Truncator is the text that ends text cut by MaxLines. If
+	// empty, an ellipsis is used.
+	Truncator string
+	// Direction is the direction of the text.
+	Direction text.Direction
+	// LetterSpacing is the extra space between characters.
+	LetterSpacing unit.Value
+	// LineHeight is the distance between the baselines of lines. If
+	// zero, the line height of the font is used.
+	LineHeight unit.Value
+	Text       string
+	TextSize   unit.Value
 
 	shaper text.Shaper
 }
 
 func H1(th *Theme, txt string) LabelStyle {
-	return Label(th, th.TextSize.Scale(96.0/16.0), txt)
+	return Label(th, th.TextStyleSize(TextStyleH1), txt)
 }
 
 func H2(th *Theme, txt string) LabelStyle {
-	return Label(th, th.TextSize.Scale(60.0/16.0), txt)
+	return Label(th, th.TextStyleSize(TextStyleH2), txt)
 }
 
 func H3(th *Theme, txt string) LabelStyle {
-	return Label(th, th.TextSize.Scale(48.0/16.0), txt)
+	return Label(th, th.TextStyleSize(TextStyleH3), txt)
 }
 
 func H4(th *Theme, txt string) LabelStyle {
-	return Label(th, th.TextSize.Scale(34.0/16.0), txt)
+	return Label(th, th.TextStyleSize(TextStyleH4), txt)
 }
 
 func H5(th *Theme, txt string) LabelStyle {
-	return Label(th, th.TextSize.Scale(24.0/16.0), txt)
+	return Label(th, th.TextStyleSize(TextStyleH5), txt)
 }
 
 func H6(th *Theme, txt string) LabelStyle {
-	return Label(th, th.TextSize.Scale(20.0/16.0), txt)
+	l := Label(th, th.TextStyleSize(TextStyleH6), txt)
+	l.Font.Weight = text.Medium
+	return l
+}
+
+// Subtitle1 returns a label for medium emphasis text.
+func Subtitle1(th *Theme, txt string) LabelStyle {
+	return Label(th, th.TextStyleSize(TextStyleSubtitle1), txt)
+}
+
+// Subtitle2 returns a label for medium emphasis text, smaller than
+// Subtitle1.
+func Subtitle2(th *Theme, txt string) LabelStyle {
+	l := Label(th, th.TextStyleSize(TextStyleSubtitle2), txt)
+	l.Font.Weight = text.Medium
+	return l
 }
 
 func Body1(th *Theme, txt string) LabelStyle {
-	return Label(th, th.TextSize, txt)
+	return Label(th, th.TextStyleSize(TextStyleBody1), txt)
 }
 
 func Body2(th *Theme, txt string) LabelStyle {
-	return Label(th, th.TextSize.Scale(14.0/16.0), txt)
+	return Label(th, th.TextStyleSize(TextStyleBody2), txt)
 }
 
 func Caption(th *Theme, txt string) LabelStyle {
-	return Label(th, th.TextSize.Scale(12.0/16.0), txt)
+	return Label(th, th.TextStyleSize(TextStyleCaption), txt)
+}
+
+// Overline returns a label for the smallest text, such as the
+// overline of a title.
+func Overline(th *Theme, txt string) LabelStyle {
+	return Label(th, th.TextStyleSize(TextStyleOverline), txt)
 }
 
 func Label(th *Theme, size unit.Value, txt string) LabelStyle {
@@ -74,6 +105,13 @@ func Label(th *Theme, size unit.Value, txt string) LabelStyle {
 
 func (l LabelStyle) Layout(gtx layout.Context) layout.Dimensions {
 	paint.ColorOp{Color: l.Color}.Add(gtx.Ops)
-	tl := widget.Label{Alignment: l.Alignment, MaxLines: l.MaxLines}
+	tl := widget.Label{
+		Alignment:     l.Alignment,
+		MaxLines:      l.MaxLines,
+		Truncator:     l.Truncator,
+		Direction:     l.Direction,
+		LetterSpacing: l.LetterSpacing,
+		LineHeight:    l.LineHeight,
+	}
 	return tl.Layout(gtx, l.shaper, l.Font, l.TextSize, l.Text)
 }
@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/internal/f32color"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// ScrollbarStyle defines the presentation of a scrollbar with a
+// draggable thumb.
+type ScrollbarStyle struct {
+	Scrollbar *widget.Scrollbar
+	Color     color.NRGBA
+	// Width is the thickness of the thumb.
+	Width unit.Value
+	// MinLength is the minimum length of the thumb.
+	MinLength unit.Value
+	// Inset is the space around the thumb, included in the area
+	// responding to input.
+	Inset unit.Value
+}
+
+// ListStyle lays out a list with a scrollbar along its end.
+type ListStyle struct {
+	state *widget.List
+	ScrollbarStyle
+}
+
+const (
+	// scrollbarIdle is the duration an idle scrollbar stays visible.
+	scrollbarIdle = time.Second
+	// scrollbarFade is the duration of the scrollbar fade out.
+	scrollbarFade = 250 * time.Millisecond
+)
+
+// Scrollbar returns a scrollbar for state.
+func Scrollbar(th *Theme, state *widget.Scrollbar) ScrollbarStyle {
+	return ScrollbarStyle{
+		Scrollbar: state,
+		Color:     f32color.MulAlpha(th.Palette.Fg, 0x88),
+		Width:     unit.Dp(6),
+		MinLength: unit.Dp(16),
+		Inset:     unit.Dp(2),
+	}
+}
+
+// List returns a list with a scrollbar for state.
+func List(th *Theme, state *widget.List) ListStyle {
+	return ListStyle{
+		state:          state,
+		ScrollbarStyle: Scrollbar(th, &state.Scrollbar),
+	}
+}
+
+// Layout the scrollbar along axis over the minimum constraints. The
+// viewport start and end are the visible fraction of the content. The
+// scrollbar is hidden when the content is fully visible, and fades out
+// when idle.
+func (s ScrollbarStyle) Layout(gtx layout.Context, axis layout.Axis, viewportStart, viewportEnd float32) layout.Dimensions {
+	size := gtx.Constraints.Min
+	if viewportStart <= 0 && viewportEnd >= 1 {
+		return layout.Dimensions{Size: size}
+	}
+	s.Scrollbar.Layout(gtx, axis, viewportStart, viewportEnd)
+
+	alpha := float32(1)
+	if !s.Scrollbar.Dragging() {
+		idle := gtx.Now.Sub(s.Scrollbar.LastActive())
+		switch {
+		case idle >= scrollbarIdle+scrollbarFade:
+			return layout.Dimensions{Size: size}
+		case idle >= scrollbarIdle:
+			alpha = 1 - float32((idle-scrollbarIdle).Seconds()/scrollbarFade.Seconds())
+			op.InvalidateOp{}.Add(gtx.Ops)
+		default:
+			op.InvalidateOp{At: s.Scrollbar.LastActive().Add(scrollbarIdle)}.Add(gtx.Ops)
+		}
+	}
+
+	inset := float32(gtx.Px(s.Inset))
+	length := float32(axis.Convert(size).X) - 2*inset
+	width := float32(gtx.Px(s.Width))
+	start, end := clamp1(viewportStart)*length, clamp1(viewportEnd)*length
+	if min := float32(gtx.Px(s.MinLength)); end-start < min {
+		// Grow the thumb around its center, keeping it on the track.
+		c := (start + end) * .5
+		start, end = c-min*.5, c+min*.5
+		if start < 0 {
+			start, end = 0, min
+		} else if end > length {
+			start, end = length-min, length
+		}
+	}
+	cross := float32(axis.Convert(size).Y)
+	thumb := f32.Rectangle{
+		Min: axisPt(axis, f32.Pt(inset+start, cross-inset-width)),
+		Max: axisPt(axis, f32.Pt(inset+end, cross-inset)),
+	}
+	col := f32color.MulAlpha(s.Color, uint8(alpha*0xff))
+	paint.FillShape(gtx.Ops, col, clip.UniformRRect(thumb, width*.5).Op(gtx.Ops))
+	return layout.Dimensions{Size: size}
+}
+
+// Layout the list and its scrollbar.
+func (l ListStyle) Layout(gtx layout.Context, length int, w layout.ListElement) layout.Dimensions {
+	state := l.state
+	axis := state.Axis
+	viewport := axis.Convert(gtx.Constraints.Max).X
+	// avg is the average size of the visible elements of the
+	// previous layout, which is used to estimate the total size.
+	pos := state.Position
+	avg := float32(viewport) / float32(max(pos.Count, 1))
+	if pos.Count > 0 {
+		avg = float32(viewport-pos.OffsetLast+pos.Offset) / float32(pos.Count)
+	}
+	if d := state.ScrollDistance(); d != 0 {
+		state.Position.Offset += int(d * avg * float32(length))
+		state.Position.BeforeEnd = true
+	}
+
+	dims := state.List.Layout(gtx, length, w)
+
+	pos = state.Position
+	if pos.Count > 0 {
+		avg = float32(axis.Convert(dims.Size).X-pos.OffsetLast+pos.Offset) / float32(pos.Count)
+	}
+	var start, end float32 = 0, 1
+	if total := avg * float32(length); total > 0 {
+		start = (float32(pos.First)*avg + float32(pos.Offset)) / total
+		end = start + float32(axis.Convert(dims.Size).X)/total
+	}
+	defer op.Save(gtx.Ops).Load()
+	bar := gtx.Px(l.Width) + 2*gtx.Px(l.Inset)
+	main := axis.Convert(dims.Size).X
+	cross := axis.Convert(dims.Size).Y
+	op.Offset(layout.FPt(axis.Convert(image.Pt(0, cross-bar)))).Add(gtx.Ops)
+	gtx.Constraints = layout.Exact(axis.Convert(image.Pt(main, bar)))
+	l.ScrollbarStyle.Layout(gtx, axis, start, end)
+	return dims
+}
+
+// axisPt converts a point in (main, cross) coordinates to (x, y).
+func axisPt(a layout.Axis, pt f32.Point) f32.Point {
+	if a == layout.Horizontal {
+		return pt
+	}
+	return f32.Pt(pt.Y, pt.X)
+}
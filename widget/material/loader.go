@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"gioui.org/f32"
+	"gioui.org/internal/f32color"
 	"gioui.org/layout"
 	"gioui.org/op"
 	"gioui.org/op/clip"
@@ -16,10 +17,23 @@ import (
 	"gioui.org/unit"
 )
 
+// LoaderStyle defines the presentation of a circular, indeterminate
+// progress indicator.
 type LoaderStyle struct {
 	Color color.NRGBA
 }
 
+// LinearLoaderStyle defines the presentation of a horizontal,
+// indeterminate progress indicator: a bar that repeatedly slides
+// across its track.
+type LinearLoaderStyle struct {
+	Color      color.NRGBA
+	TrackColor color.NRGBA
+	// Height is the thickness of the track.
+	Height unit.Value
+}
+
+// Loader returns a spinning arc loader.
 func Loader(th *Theme) LoaderStyle {
 	return LoaderStyle{
 		Color: th.Palette.ContrastBg,
@@ -55,6 +69,45 @@ func (l LoaderStyle) Layout(gtx layout.Context) layout.Dimensions {
 	}
 }
 
+// LinearLoader returns a sliding bar loader.
+func LinearLoader(th *Theme) LinearLoaderStyle {
+	return LinearLoaderStyle{
+		Color:      th.Palette.ContrastBg,
+		TrackColor: f32color.MulAlpha(th.Palette.ContrastBg, 0x44),
+		Height:     unit.Dp(4),
+	}
+}
+
+func (l LinearLoaderStyle) Layout(gtx layout.Context) layout.Dimensions {
+	// The duration of a single pass of the bar.
+	const period = 1500 * time.Millisecond
+	// The length of the bar relative to the track.
+	const length = .4
+
+	sz := image.Pt(gtx.Constraints.Max.X, gtx.Px(l.Height))
+	sz = gtx.Constraints.Constrain(sz)
+	defer op.Save(gtx.Ops).Load()
+	width, height := float32(sz.X), float32(sz.Y)
+	track := f32.Rectangle{Max: f32.Pt(width, height)}
+	clip.UniformRRect(track, height*.5).Add(gtx.Ops)
+	paint.ColorOp{Color: l.TrackColor}.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+
+	t := float32((time.Duration(gtx.Now.UnixNano()) % period).Seconds() / period.Seconds())
+	// Slide the bar from just outside the left edge to just
+	// outside the right edge.
+	x := (t*(1+length) - length) * width
+	bar := f32.Rectangle{
+		Min: f32.Pt(x, 0),
+		Max: f32.Pt(x+width*length, height),
+	}
+	clip.UniformRRect(bar, height*.5).Add(gtx.Ops)
+	paint.ColorOp{Color: l.Color}.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	op.InvalidateOp{}.Add(gtx.Ops)
+	return layout.Dimensions{Size: sz}
+}
+
 func clipLoader(ops *op.Ops, startAngle, endAngle, radius float32) {
 	const thickness = .25
 
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/f32"
+	"gioui.org/internal/f32color"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// MenuItem describes an item of a menu.
+type MenuItem struct {
+	Text string
+	// Icon is an optional icon drawn before the text.
+	Icon *widget.Icon
+	// Shortcut is an optional text drawn at the end of the item,
+	// such as a keyboard shortcut.
+	Shortcut string
+}
+
+// MenuStyle defines the presentation of a popup menu on a raised
+// surface.
+type MenuStyle struct {
+	Items []MenuItem
+	// Color is the text and icon color.
+	Color color.NRGBA
+	// ShortcutColor is the color of the item shortcuts.
+	ShortcutColor color.NRGBA
+	Background    color.NRGBA
+	DisabledColor color.NRGBA
+	CornerRadius  unit.Value
+	Elevation     unit.Value
+	// MinWidth is the minimum width of the menu.
+	MinWidth  unit.Value
+	Font      text.Font
+	TextSize  unit.Value
+	IconSize  unit.Value
+	InkColor  color.NRGBA
+	ItemInset layout.Inset
+	Menu      *widget.Menu
+	shaper    text.Shaper
+}
+
+// Menu returns a menu of items.
+func Menu(th *Theme, menu *widget.Menu, items ...MenuItem) MenuStyle {
+	return MenuStyle{
+		Items:         items,
+		Color:         th.Palette.OnSurface,
+		ShortcutColor: f32color.MulAlpha(th.Palette.OnSurface, 0xaa),
+		Background:    th.Palette.Surface,
+		DisabledColor: th.Palette.Disabled,
+		CornerRadius:  unit.Dp(4),
+		Elevation:     unit.Dp(8),
+		MinWidth:      unit.Dp(112),
+		TextSize:      th.TextStyleSize(TextStyleBody2),
+		IconSize:      unit.Dp(24),
+		ItemInset: layout.Inset{
+			Top: unit.Dp(8), Bottom: unit.Dp(8),
+			Left: unit.Dp(16), Right: unit.Dp(16),
+		},
+		Menu:   menu,
+		shaper: th.Shaper,
+	}
+}
+
+// Layout the menu at its anchor, if visible. The maximum constraints
+// are the bounds of the window, within which the menu is kept; lay
+// out the menu on top of the window content.
+func (m MenuStyle) Layout(gtx layout.Context) layout.Dimensions {
+	bounds := gtx.Constraints.Max
+	if !m.Menu.Visible() {
+		return layout.Dimensions{Size: bounds}
+	}
+	// Measure the items to find the menu width.
+	width := gtx.Px(m.MinWidth)
+	mgtx := gtx
+	mgtx.Constraints.Min = image.Point{}
+	for i := range m.Items {
+		macro := op.Record(gtx.Ops)
+		dims := m.ItemInset.Layout(mgtx, func(gtx layout.Context) layout.Dimensions {
+			return m.layoutContent(gtx, i, false)
+		})
+		macro.Stop()
+		if dims.Size.X > width {
+			width = dims.Size.X
+		}
+	}
+	if width > bounds.X {
+		width = bounds.X
+	}
+	// Record the surface.
+	macro := op.Record(gtx.Ops)
+	sgtx := gtx
+	sgtx.Constraints = layout.Constraints{
+		Min: image.Pt(width, 0),
+		Max: image.Pt(width, bounds.Y),
+	}
+	var children []layout.FlexChild
+	for i := range m.Items {
+		i := i
+		children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			return m.layoutItem(gtx, i)
+		}))
+	}
+	dims := layout.Flex{Axis: layout.Vertical}.Layout(sgtx, children...)
+	call := macro.Stop()
+
+	// Place the menu below the anchor, or above when it doesn't fit.
+	pos := m.Menu.Anchor()
+	size := dims.Size
+	if pos.X+size.X > bounds.X {
+		pos.X = bounds.X - size.X
+	}
+	if pos.Y+size.Y > bounds.Y {
+		pos.Y -= size.Y
+	}
+	if pos.X < 0 {
+		pos.X = 0
+	}
+	if pos.Y < 0 {
+		pos.Y = 0
+	}
+	surface := image.Rectangle{Min: pos, Max: pos.Add(size)}
+
+	gtx.Constraints.Min = bounds
+	m.Menu.Layout(gtx, surface)
+
+	defer op.Save(gtx.Ops).Load()
+	op.Offset(layout.FPt(pos)).Add(gtx.Ops)
+	r := f32.Rectangle{Max: layout.FPt(size)}
+	rr := clip.UniformRRect(r, float32(gtx.Px(m.CornerRadius)))
+	// Keep the shadow within the bounds of the menu.
+	drawShadow(gtx, rr, float32(gtx.Px(m.Elevation)), image.Rectangle{Max: bounds}.Sub(pos))
+	rr.Add(gtx.Ops)
+	paint.Fill(gtx.Ops, m.Background)
+	call.Add(gtx.Ops)
+	return layout.Dimensions{Size: bounds}
+}
+
+// layoutItem lays out the background, input handler and content of
+// item i.
+func (m MenuStyle) layoutItem(gtx layout.Context, i int) layout.Dimensions {
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			defer op.Save(gtx.Ops).Load()
+			clip.Rect{Max: gtx.Constraints.Min}.Add(gtx.Ops)
+			if m.Menu.Hovered(i) {
+				paint.Fill(gtx.Ops, f32color.MulAlpha(m.Color, 0x14))
+			}
+			drawInks(gtx, m.Menu.History(i), m.InkColor, defaultInkDuration)
+			return m.Menu.LayoutItem(gtx, i)
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			return m.ItemInset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				return m.layoutContent(gtx, i, true)
+			})
+		}),
+	)
+}
+
+// layoutContent lays out the icon, text and shortcut of item i. If fill
+// is set, the shortcut is aligned to the end of the minimum width.
+func (m MenuStyle) layoutContent(gtx layout.Context, i int, fill bool) layout.Dimensions {
+	item := m.Items[i]
+	col := blendDisabledColor(gtx.Queue == nil, m.DisabledColor, m.Color)
+	var children []layout.FlexChild
+	if item.Icon != nil {
+		children = append(children,
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				item.Icon.Color = col
+				return item.Icon.Layout(gtx, m.IconSize)
+			}),
+			layout.Rigid(layout.Spacer{Width: unit.Dp(12)}.Layout),
+		)
+	}
+	children = append(children, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+		paint.ColorOp{Color: col}.Add(gtx.Ops)
+		return widget.Label{MaxLines: 1}.Layout(gtx, m.shaper, m.Font, m.TextSize, item.Text)
+	}))
+	if item.Shortcut != "" {
+		gap := layout.Rigid(layout.Spacer{Width: unit.Dp(24)}.Layout)
+		if fill {
+			gap = layout.Flexed(1, layout.Spacer{Width: unit.Dp(24)}.Layout)
+		}
+		children = append(children, gap, layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			paint.ColorOp{Color: blendDisabledColor(gtx.Queue == nil, m.DisabledColor, m.ShortcutColor)}.Add(gtx.Ops)
+			return widget.Label{MaxLines: 1}.Layout(gtx, m.shaper, m.Font, m.TextSize, item.Shortcut)
+		}))
+	}
+	if !fill {
+		gtx.Constraints.Min.X = 0
+	}
+	return layout.Flex{Alignment: layout.Middle}.Layout(gtx, children...)
+}
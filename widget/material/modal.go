@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/internal/f32color"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/widget"
+)
+
+// ModalStyle defines the presentation of a modal overlay, which dims
+// the content beneath it with a scrim.
+type ModalStyle struct {
+	Modal      *widget.Modal
+	ScrimColor color.NRGBA
+}
+
+// modalDuration is the duration of the modal opening and closing
+// animation.
+const modalDuration = 200 * time.Millisecond
+
+// Modal returns the presentation of state.
+func Modal(th *Theme, state *widget.Modal) ModalStyle {
+	return ModalStyle{
+		Modal:      state,
+		ScrimColor: argb(0x80000000),
+	}
+}
+
+// Layout the scrim over the maximum constraints, and w centered on
+// top of it. Nothing is drawn while the modal is closed.
+func (m ModalStyle) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
+	state := m.Modal
+	state.Update(gtx)
+	size := gtx.Constraints.Max
+	dt := gtx.Now.Sub(state.ChangeTime())
+	if !state.Visible() && dt >= modalDuration {
+		return layout.Dimensions{Size: size}
+	}
+	p := float32(1)
+	if dt < modalDuration {
+		p = float32(dt.Seconds() / modalDuration.Seconds())
+		op.InvalidateOp{}.Add(gtx.Ops)
+	}
+	if !state.Visible() {
+		p = 1 - p
+	}
+	defer op.Save(gtx.Ops).Load()
+	scrim := f32color.MulAlpha(m.ScrimColor, uint8(p*0xff))
+	paint.FillShape(gtx.Ops, scrim, clip.Rect{Max: size}.Op())
+	gtx.Constraints.Min = size
+	return state.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints.Min = image.Point{}
+		macro := op.Record(gtx.Ops)
+		dims := w(gtx)
+		call := macro.Stop()
+		defer op.Save(gtx.Ops).Load()
+		// Grow the content from 90% of its size.
+		s := .9 + .1*p
+		center := layout.FPt(dims.Size).Mul(.5)
+		op.Affine(f32.Affine2D{}.Scale(center, f32.Pt(s, s))).Add(gtx.Ops)
+		call.Add(gtx.Ops)
+		return dims
+	})
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/font/gofont"
+	"gioui.org/internal/opconst"
+	"gioui.org/internal/ops"
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget"
+)
+
+func TestModalAnimation(t *testing.T) {
+	var (
+		o     op.Ops
+		r     router.Router
+		state = widget.Modal{Dismissible: true}
+	)
+	th := NewTheme(gofont.Collection())
+	start := time.Unix(0, 0)
+	gtx := layout.Context{
+		Ops:         &o,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	// frame lays out the modal at dt after start and returns the
+	// alpha of the scrim, or -1 if none is drawn.
+	frame := func(dt time.Duration, e ...event.Event) (alpha int, invalidated bool) {
+		r.Queue(e...)
+		o.Reset()
+		gtx.Now = start.Add(dt)
+		Modal(th, &state).Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layout.Dimensions{Size: image.Pt(20, 20)}
+		})
+		r.Frame(&o)
+		_, invalidated = r.WakeupTime()
+		alpha = -1
+		var rd ops.Reader
+		rd.Reset(&o)
+		for encOp, ok := rd.Decode(); ok; encOp, ok = rd.Decode() {
+			if opconst.OpType(encOp.Data[0]) == opconst.TypeColor {
+				alpha = int(encOp.Data[4])
+				break
+			}
+		}
+		return
+	}
+	check := func(name string, alpha int, invalidated bool, wantAlpha int, wantInvalidated bool) {
+		t.Helper()
+		if alpha != wantAlpha || invalidated != wantInvalidated {
+			t.Errorf("%s: got scrim alpha %d (invalidated: %v), expected %d (invalidated: %v)",
+				name, alpha, invalidated, wantAlpha, wantInvalidated)
+		}
+	}
+	state.Open()
+	a, inv := frame(0)
+	check("opening", a, inv, 0, true)
+	a, inv = frame(modalDuration / 2)
+	check("half open", a, inv, 0x80*0x7f/0xff, true)
+	a, inv = frame(modalDuration)
+	check("open", a, inv, 0x80, false)
+
+	state.Close()
+	a, inv = frame(2 * modalDuration)
+	check("closing", a, inv, 0x80, true)
+	a, inv = frame(3 * modalDuration)
+	check("closed", a, inv, -1, false)
+
+	state.Open()
+	frame(4 * modalDuration)
+	frame(5 * modalDuration)
+	a, inv = frame(6*modalDuration, key.Event{Name: key.NameEscape, State: key.Press})
+	check("dismissing", a, inv, 0x80, true)
+	if state.Visible() {
+		t.Error("modal open after Escape")
+	}
+}
@@ -14,28 +14,37 @@ import (
 	"gioui.org/unit"
 )
 
+// ProgressBarStyle defines the presentation of a horizontal,
+// determinate progress indicator.
 type ProgressBarStyle struct {
-	Color      color.NRGBA
-	TrackColor color.NRGBA
-	Progress   float32
+	Color         color.NRGBA
+	TrackColor    color.NRGBA
+	DisabledColor color.NRGBA
+	// Height is the thickness of the bar. The ends of the bar are
+	// rounded with a radius of half the height.
+	Height unit.Value
+	// Progress is the completed fraction in the range [0..1].
+	// Values outside the range are clamped.
+	Progress float32
 }
 
+// ProgressBar returns a progress bar showing progress.
 func ProgressBar(th *Theme, progress float32) ProgressBarStyle {
 	return ProgressBarStyle{
-		Progress:   progress,
-		Color:      th.Palette.ContrastBg,
-		TrackColor: f32color.MulAlpha(th.Palette.Fg, 0x88),
+		Progress:      progress,
+		Height:        unit.Dp(4),
+		Color:         th.Palette.ContrastBg,
+		TrackColor:    f32color.MulAlpha(th.Palette.Fg, 0x88),
+		DisabledColor: th.Palette.Disabled,
 	}
 }
 
 func (p ProgressBarStyle) Layout(gtx layout.Context) layout.Dimensions {
 	shader := func(width float32, color color.NRGBA) layout.Dimensions {
-		maxHeight := unit.Dp(4)
-		rr := float32(gtx.Px(unit.Dp(2)))
+		d := image.Point{X: int(width), Y: gtx.Px(p.Height)}
 
-		d := image.Point{X: int(width), Y: gtx.Px(maxHeight)}
-
-		height := float32(gtx.Px(maxHeight))
+		height := float32(d.Y)
+		rr := height * .5
 		clip.UniformRRect(f32.Rectangle{Max: f32.Pt(width, height)}, rr).Add(gtx.Ops)
 		paint.ColorOp{Color: color}.Add(gtx.Ops)
 		paint.PaintOp{}.Add(gtx.Ops)
@@ -50,10 +59,7 @@ func (p ProgressBarStyle) Layout(gtx layout.Context) layout.Dimensions {
 		}),
 		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
 			fillWidth := progressBarWidth * clamp1(p.Progress)
-			fillColor := p.Color
-			if gtx.Queue == nil {
-				fillColor = f32color.Disabled(fillColor)
-			}
+			fillColor := blendDisabledColor(gtx.Queue == nil, p.DisabledColor, p.Color)
 			return shader(fillWidth, fillColor)
 		}),
 	)
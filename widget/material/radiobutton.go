@@ -3,7 +3,12 @@
 package material
 
 import (
+	"gioui.org/f32"
+	"gioui.org/io/semantic"
 	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
 	"gioui.org/unit"
 	"gioui.org/widget"
 )
@@ -22,13 +27,12 @@ func RadioButton(th *Theme, group *widget.Enum, key, label string) RadioButtonSt
 		checkable: checkable{
 			Label: label,
 
-			Color:              th.Palette.Fg,
-			IconColor:          th.Palette.ContrastBg,
-			TextSize:           th.TextSize.Scale(14.0 / 16.0),
-			Size:               unit.Dp(26),
-			shaper:             th.Shaper,
-			checkedStateIcon:   th.Icon.RadioChecked,
-			uncheckedStateIcon: th.Icon.RadioUnchecked,
+			Color:         th.Palette.Fg,
+			IconColor:     th.Palette.ContrastBg,
+			DisabledColor: th.Palette.Disabled,
+			TextSize:      th.TextStyleSize(TextStyleBody2),
+			Size:          unit.Dp(26),
+			shaper:        th.Shaper,
 		},
 		Key: key,
 	}
@@ -37,8 +41,45 @@ func RadioButton(th *Theme, group *widget.Enum, key, label string) RadioButtonSt
 // Layout updates enum and displays the radio button.
 func (r RadioButtonStyle) Layout(gtx layout.Context) layout.Dimensions {
 	hovered, hovering := r.Group.Hovered()
-	dims := r.layout(gtx, r.Group.Value == r.Key, hovering && hovered == r.Key)
+	dims := r.layout(gtx, hovering && hovered == r.Key, r.Group.History(r.Key), r.drawRing)
 	gtx.Constraints.Min = dims.Size
 	r.Group.Layout(gtx, r.Key)
+	describe(gtx, dims.Size, description{
+		class:    semantic.RadioButton,
+		label:    r.Label,
+		desc:     r.Description,
+		selected: r.Group.Value == r.Key,
+	})
 	return dims
 }
+
+// drawRing draws the outer ring and the inner dot, which grows when
+// the button is selected and shrinks when it is deselected.
+func (r RadioButtonStyle) drawRing(gtx layout.Context) layout.Dimensions {
+	size := gtx.Constraints.Min
+	// The ring and dot are laid out on a grid of 24 units, matching
+	// the material radio button icons.
+	scale := float32(size.X) / 24
+	center := f32.Pt(12*scale, 12*scale)
+	var progress float32
+	selected, deselected := r.Group.Value == r.Key, r.Group.Previous() == r.Key
+	if selected || deselected {
+		progress = 1
+		if dt := gtx.Now.Sub(r.Group.ChangeTime()); dt < toggleDuration {
+			progress = float32(dt.Seconds() / toggleDuration.Seconds())
+			op.InvalidateOp{}.Add(gtx.Ops)
+		}
+		if !selected {
+			progress = 1 - progress
+		}
+	}
+	col := blendDisabledColor(gtx.Queue == nil, r.DisabledColor, r.IconColor)
+	paint.FillShape(gtx.Ops, col, clip.Stroke{
+		Path:  clip.Circle{Center: center, Radius: 9 * scale}.Path(gtx.Ops),
+		Style: clip.StrokeStyle{Width: 2 * scale},
+	}.Op())
+	if progress > 0 {
+		paint.FillShape(gtx.Ops, col, clip.Circle{Center: center, Radius: 5 * scale * progress}.Op(gtx.Ops))
+	}
+	return layout.Dimensions{Size: size}
+}
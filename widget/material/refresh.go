@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/internal/f32color"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// RefreshStyle defines the presentation of a pull-to-refresh
+// indicator: a disc with a progress arc that follows the pull, and
+// spins while refreshing.
+type RefreshStyle struct {
+	Refresh    *widget.Refresh
+	Color      color.NRGBA
+	Background color.NRGBA
+	// Size is the diameter of the indicator disc.
+	Size unit.Value
+	// Distance is the distance of the indicator from the top while
+	// refreshing.
+	Distance unit.Value
+}
+
+// refreshEnd is the duration of the indicator shrinking at the
+// end of a refresh.
+const refreshEnd = 200 * time.Millisecond
+
+// PullToRefresh returns a pull-to-refresh indicator for state.
+func PullToRefresh(th *Theme, state *widget.Refresh) RefreshStyle {
+	return RefreshStyle{
+		Refresh:    state,
+		Color:      th.Palette.ContrastBg,
+		Background: th.Palette.Surface,
+		Size:       unit.Dp(40),
+		Distance:   unit.Dp(16),
+	}
+}
+
+// Layout the list l with w, and the indicator over the top center of
+// the list.
+func (r RefreshStyle) Layout(gtx layout.Context, l *layout.List, w layout.Widget) layout.Dimensions {
+	dims := r.Refresh.Layout(gtx, l, w)
+
+	size := float32(gtx.Px(r.Size))
+	pos := r.Refresh.Pull()
+	if pos > 1 {
+		pos = 1
+	}
+	scale := float32(1)
+	spin := r.Refresh.Refreshing()
+	if spin {
+		pos = 1
+	} else if pos == 0 {
+		// Shrink the indicator after a refresh.
+		t := gtx.Now.Sub(r.Refresh.ChangeTime())
+		if r.Refresh.ChangeTime().IsZero() || t >= refreshEnd {
+			return dims
+		}
+		pos, scale = 1, 1-float32(t.Seconds()/refreshEnd.Seconds())
+		op.InvalidateOp{}.Add(gtx.Ops)
+	}
+
+	defer op.Save(gtx.Ops).Load()
+	clip.Rect{Max: dims.Size}.Add(gtx.Ops)
+	center := f32.Pt(float32(dims.Size.X)*.5, pos*(float32(gtx.Px(r.Distance))+size)-size*.5)
+	op.Affine(f32.Affine2D{}.Scale(f32.Point{}, f32.Pt(scale, scale)).Offset(center)).Add(gtx.Ops)
+	radius := size * .5
+	disc := f32.Rectangle{Min: f32.Pt(-radius, -radius), Max: f32.Pt(radius, radius)}
+	// The clip above confines the shadow to the dimensions of the
+	// widget.
+	elevation := unit.Dp(2)
+	bounds := shadowBounds(gtx, image.Pt(int(size+1), int(size+1)), elevation).Add(image.Pt(int(-radius), int(-radius)))
+	drawShadow(gtx, clip.UniformRRect(disc, radius), float32(gtx.Px(elevation)), bounds)
+	paint.FillShape(gtx.Ops, r.Background, clip.Ellipse(disc).Op(gtx.Ops))
+
+	var start, end float32
+	if spin {
+		dt := float32((time.Duration(gtx.Now.UnixNano()) % time.Second).Seconds())
+		start = dt * math.Pi * 2
+		end = start + math.Pi*1.5
+		op.InvalidateOp{}.Add(gtx.Ops)
+	} else {
+		// The arc grows with the pull, and closes when armed.
+		start = -math.Pi * .5
+		end = start + pos*math.Pi*1.6
+		if r.Refresh.Armed() {
+			end = start + math.Pi*2
+		}
+	}
+	col := r.Color
+	if !spin && !r.Refresh.Armed() {
+		col = f32color.MulAlpha(col, 0x99)
+	}
+	clipLoader(gtx.Ops, start, end, radius*.6)
+	paint.ColorOp{Color: col}.Add(gtx.Ops)
+	paint.PaintOp{}.Add(gtx.Ops)
+	return dims
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image/color"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// ReorderStyle defines the presentation of a reorderable list. The
+// dragged item is lifted above the others with a shadow.
+type ReorderStyle struct {
+	Reorder *widget.Reorder
+	// Background fills the lifted item.
+	Background color.NRGBA
+	// Elevation is the height of the lifted item.
+	Elevation    unit.Value
+	CornerRadius unit.Value
+}
+
+// Reorder returns a reorderable list for state.
+func Reorder(th *Theme, state *widget.Reorder) ReorderStyle {
+	return ReorderStyle{
+		Reorder:    state,
+		Background: th.Palette.Surface,
+		Elevation:  unit.Dp(8),
+	}
+}
+
+// Layout n items with w in l.
+func (r ReorderStyle) Layout(gtx layout.Context, l *layout.List, n int, w layout.ListElement) layout.Dimensions {
+	return r.Reorder.Layout(gtx, l, n, func(gtx layout.Context, i int) layout.Dimensions {
+		if !r.Reorder.Lifted(i) {
+			return w(gtx, i)
+		}
+		macro := op.Record(gtx.Ops)
+		dims := w(gtx, i)
+		call := macro.Stop()
+		rect := f32.Rectangle{Max: layout.FPt(dims.Size)}
+		rr := clip.UniformRRect(rect, float32(gtx.Px(r.CornerRadius)))
+		// The lifted item floats over its neighbours, and the list
+		// clips its shadow to the list viewport.
+		drawShadow(gtx, rr, float32(gtx.Px(r.Elevation)), shadowBounds(gtx, dims.Size, r.Elevation))
+		paint.FillShape(gtx.Ops, r.Background, rr.Op(gtx.Ops))
+		call.Add(gtx.Ops)
+		return dims
+	})
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/internal/f32color"
+	"gioui.org/io/semantic"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// SegmentedStyle defines the presentation of a row of connected
+// buttons that select a value of an Enum, or several keys of a
+// Selection.
+type SegmentedStyle struct {
+	Enum *widget.Enum
+	// Selection, if set, replaces Enum and allows several segments
+	// to be selected.
+	Selection *widget.Selection
+	Segments  []Segment
+	// Color is the text color of the unselected segments.
+	Color color.NRGBA
+	// SelectedColor is the text color of the selected segments.
+	SelectedColor color.NRGBA
+	// SelectedBackground fills the selected segments.
+	SelectedBackground color.NRGBA
+	BorderColor        color.NRGBA
+	BorderWidth        unit.Value
+	DisabledColor      color.NRGBA
+	// CornerRadius is the radius of the outer corners of the first
+	// and last segments.
+	CornerRadius unit.Value
+	Font         text.Font
+	TextSize     unit.Value
+	Inset        layout.Inset
+	InkDuration  time.Duration
+	shaper       text.Shaper
+}
+
+// Segment is a key of an Enum or Selection and its label.
+type Segment struct {
+	Key  string
+	Text string
+}
+
+// segmentState is the state common to Enum and Selection.
+type segmentState interface {
+	Hovered() (string, bool)
+	Focused() (string, bool)
+	History(key string) []widget.Press
+	Layout(gtx layout.Context, key string) layout.Dimensions
+}
+
+// Segmented returns connected buttons selecting the keys of enum.
+func Segmented(th *Theme, enum *widget.Enum, segments ...Segment) SegmentedStyle {
+	return SegmentedStyle{
+		Enum:               enum,
+		Segments:           segments,
+		Color:              th.Palette.ContrastBg,
+		SelectedColor:      th.Palette.ContrastFg,
+		SelectedBackground: th.Palette.ContrastBg,
+		BorderColor:        th.Palette.ContrastBg,
+		BorderWidth:        unit.Dp(1),
+		DisabledColor:      th.Palette.Disabled,
+		CornerRadius:       unit.Dp(4),
+		TextSize:           th.TextStyleSize(TextStyleButton),
+		Inset: layout.Inset{
+			Top: unit.Dp(10), Bottom: unit.Dp(10),
+			Left: unit.Dp(12), Right: unit.Dp(12),
+		},
+		InkDuration: defaultInkDuration,
+		shaper:      th.Shaper,
+	}
+}
+
+// MultiSegmented returns connected buttons toggling the keys of
+// selection.
+func MultiSegmented(th *Theme, selection *widget.Selection, segments ...Segment) SegmentedStyle {
+	s := Segmented(th, nil, segments...)
+	s.Selection = selection
+	return s
+}
+
+// Layout the segments in a row of equal width segments, wide enough
+// for the widest label.
+func (s SegmentedStyle) Layout(gtx layout.Context) layout.Dimensions {
+	n := len(s.Segments)
+	if n == 0 {
+		return layout.Dimensions{Size: gtx.Constraints.Min}
+	}
+	var size image.Point
+	for _, seg := range s.Segments {
+		macro := op.Record(gtx.Ops)
+		cgtx := gtx
+		cgtx.Constraints.Min = image.Point{}
+		dims := s.label(cgtx, seg.Text)
+		macro.Stop()
+		if dims.Size.X > size.X {
+			size.X = dims.Size.X
+		}
+		if dims.Size.Y > size.Y {
+			size.Y = dims.Size.Y
+		}
+	}
+	if w := gtx.Constraints.Min.X / n; w > size.X {
+		size.X = w
+	}
+	if w := gtx.Constraints.Max.X / n; w < size.X {
+		size.X = w
+	}
+	total := gtx.Constraints.Constrain(image.Pt(size.X*n, size.Y))
+	size.Y = total.Y
+	r := float32(gtx.Px(s.CornerRadius))
+	for i, seg := range s.Segments {
+		stack := op.Save(gtx.Ops)
+		x := i * size.X
+		op.Offset(f32.Pt(float32(x), 0)).Add(gtx.Ops)
+		sz := size
+		if i == n-1 {
+			// The last segment covers any remaining width.
+			sz.X = total.X - x
+		}
+		rr := clip.RRect{Rect: f32.Rectangle{Max: layout.FPt(sz)}}
+		if i == 0 {
+			rr.NW, rr.SW = r, r
+		}
+		if i == n-1 {
+			rr.NE, rr.SE = r, r
+		}
+		cgtx := gtx
+		cgtx.Constraints = layout.Exact(sz)
+		s.layoutSegment(cgtx, rr, seg)
+		stack.Load()
+	}
+	outline := clip.RRect{
+		Rect: f32.Rectangle{Max: layout.FPt(total)},
+		SE:   r, SW: r, NW: r, NE: r,
+	}
+	if w := gtx.Px(s.BorderWidth); w > 0 {
+		col := blendDisabledColor(gtx.Queue == nil, s.DisabledColor, s.BorderColor)
+		drawBorder(gtx, outline, float32(w), col)
+		// Divide the segments.
+		for i := 1; i < n; i++ {
+			x := i*size.X - w/2
+			paint.FillShape(gtx.Ops, col, clip.Rect{Min: image.Pt(x, 0), Max: image.Pt(x+w, total.Y)}.Op())
+		}
+	}
+	return layout.Dimensions{Size: total}
+}
+
+// layoutSegment draws and lays out the input of a segment shaped as rr.
+func (s SegmentedStyle) layoutSegment(gtx layout.Context, rr clip.RRect, seg Segment) {
+	var (
+		state    segmentState = s.Enum
+		selected              = s.Enum != nil && s.Enum.Value == seg.Key
+		class                 = semantic.RadioButton
+	)
+	if sel := s.Selection; sel != nil {
+		state = sel
+		selected = sel.Selected[seg.Key]
+		class = semantic.CheckBox
+	}
+	hovered, hovering := state.Hovered()
+	hovering = hovering && hovered == seg.Key
+	focused, focusing := state.Focused()
+	focusing = focusing && focused == seg.Key
+	fg := s.Color
+	if selected {
+		fg = s.SelectedColor
+	}
+	stack := op.Save(gtx.Ops)
+	rr.Add(gtx.Ops)
+	var bg color.NRGBA
+	switch {
+	case gtx.Queue == nil:
+		if selected {
+			bg = disabledColor(s.DisabledColor, s.SelectedBackground)
+		}
+	case selected && hovering:
+		bg = f32color.Hovered(s.SelectedBackground)
+	case selected:
+		bg = s.SelectedBackground
+	case hovering:
+		bg = f32color.MulAlpha(s.SelectedBackground, 0x20)
+	}
+	if bg.A > 0 {
+		paint.Fill(gtx.Ops, bg)
+	}
+	if gtx.Queue != nil {
+		drawInks(gtx, state.History(seg.Key), color.NRGBA{}, s.InkDuration)
+	}
+	stack.Load()
+	if focusing && gtx.Queue != nil {
+		drawBorder(gtx, rr, float32(gtx.Px(unit.Dp(2))), fg)
+	}
+	stack = op.Save(gtx.Ops)
+	paint.ColorOp{Color: blendDisabledColor(gtx.Queue == nil, s.DisabledColor, fg)}.Add(gtx.Ops)
+	layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return s.label(gtx, seg.Text)
+	})
+	stack.Load()
+	state.Layout(gtx, seg.Key)
+	describe(gtx, gtx.Constraints.Min, description{class: class, label: seg.Text, selected: selected})
+}
+
+func (s SegmentedStyle) label(gtx layout.Context, txt string) layout.Dimensions {
+	return s.Inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return widget.Label{Alignment: text.Middle}.Layout(gtx, s.shaper, s.Font, s.TextSize, txt)
+	})
+}
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"reflect"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/font/gofont"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget"
+)
+
+func TestSegmented(t *testing.T) {
+	th := NewTheme(gofont.Collection())
+	segments := []Segment{{Key: "a", Text: "A"}, {Key: "b", Text: "B"}, {Key: "c", Text: "C"}}
+	var (
+		enum widget.Enum
+		sel  widget.Selection
+	)
+	for _, tc := range []struct {
+		name  string
+		style SegmentedStyle
+		// clicks are the indices of the clicked segments.
+		clicks []int
+		value  string
+		keys   map[string]bool
+	}{
+		{
+			name:   "single",
+			style:  Segmented(th, &enum, segments...),
+			clicks: []int{1, 2},
+			value:  "c",
+		},
+		{
+			name:   "multi",
+			style:  MultiSegmented(th, &sel, segments...),
+			clicks: []int{0, 2, 0, 1},
+			keys:   map[string]bool{"b": true, "c": true},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				ops op.Ops
+				r   router.Router
+			)
+			gtx := layout.Context{
+				Ops:   &ops,
+				Queue: &r,
+				Constraints: layout.Constraints{
+					Min: image.Pt(300, 0),
+					Max: image.Pt(300, 100),
+				},
+			}
+			frame := func(e ...event.Event) layout.Dimensions {
+				r.Queue(e...)
+				ops.Reset()
+				dims := tc.style.Layout(gtx)
+				r.Frame(&ops)
+				return dims
+			}
+			dims := frame()
+			if got := dims.Size.X; got != 300 {
+				t.Errorf("got width %d, expected the minimum width 300", got)
+			}
+			for _, i := range tc.clicks {
+				// Each segment is a third of the width.
+				pos := f32.Pt(float32(i*100+50), float32(dims.Size.Y/2))
+				press := pointer.Event{
+					Type:     pointer.Press,
+					Source:   pointer.Mouse,
+					Buttons:  pointer.ButtonPrimary,
+					Position: pos,
+				}
+				release := press
+				release.Type = pointer.Release
+				frame(press, release)
+			}
+			if got := enum.Value; tc.keys == nil && got != tc.value {
+				t.Errorf("got value %q, expected %q", got, tc.value)
+			}
+			if got := sel.Selected; tc.keys != nil && !reflect.DeepEqual(got, tc.keys) {
+				t.Errorf("got selection %v, expected %v", got, tc.keys)
+			}
+		})
+	}
+}
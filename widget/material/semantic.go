@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+
+	"gioui.org/io/pointer"
+	"gioui.org/io/semantic"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// description is the semantics of a component, for accessibility
+// services.
+type description struct {
+	class semantic.ClassOp
+	label string
+	desc  string
+	value string
+	// selected is the state of checkable classes.
+	selected bool
+}
+
+// describe adds a pass-through area of size described by d. The area
+// doesn't affect the input of the component.
+func describe(gtx layout.Context, size image.Point, d description) {
+	defer op.Save(gtx.Ops).Load()
+	pointer.PassOp{Pass: true}.Add(gtx.Ops)
+	pointer.Rect(image.Rectangle{Max: size}).Add(gtx.Ops)
+	d.class.Add(gtx.Ops)
+	if d.label != "" {
+		semantic.LabelOp(d.label).Add(gtx.Ops)
+	}
+	if d.desc != "" {
+		semantic.DescriptionOp(d.desc).Add(gtx.Ops)
+	}
+	if d.value != "" {
+		semantic.ValueOp(d.value).Add(gtx.Ops)
+	}
+	switch d.class {
+	case semantic.CheckBox, semantic.RadioButton, semantic.Switch:
+		semantic.SelectedOp(d.selected).Add(gtx.Ops)
+	}
+	if gtx.Queue == nil {
+		semantic.DisabledOp(true).Add(gtx.Ops)
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+)
+
+// Shadow paints the shadow cast by a rectangle with rounded corners
+// at the given elevation. The rectangle is in pixels.
+//
+// The shadow is clipped to the minimum constraints, which are the
+// dimensions of the widget. Inset the rectangle by ShadowInset within
+// the widget to leave room for the whole shadow.
+func Shadow(gtx layout.Context, rect f32.Rectangle, cornerRadius, elevation unit.Value) {
+	bounds := image.Rectangle{Max: gtx.Constraints.Min}
+	drawShadow(gtx, clip.UniformRRect(rect, float32(gtx.Px(cornerRadius))), float32(gtx.Px(elevation)), bounds)
+}
+
+// ShadowInset returns the room taken by the shadow around a
+// rectangle at elevation.
+func ShadowInset(elevation unit.Value) layout.Inset {
+	return layout.Inset{
+		Top:    elevation.Scale(.5),
+		Right:  elevation,
+		Bottom: elevation.Scale(1.5),
+		Left:   elevation,
+	}
+}
+
+// shadowBounds returns the bounds of the shadow around a rectangle
+// of size at the origin, after reserving ShadowInset around it.
+func shadowBounds(gtx layout.Context, size image.Point, elevation unit.Value) image.Rectangle {
+	in := ShadowInset(elevation)
+	return image.Rectangle{
+		Min: image.Pt(-gtx.Px(in.Left), -gtx.Px(in.Top)),
+		Max: size.Add(image.Pt(gtx.Px(in.Right), gtx.Px(in.Bottom))),
+	}
+}
+
+// drawShadow paints a soft shadow for a rounded rectangle raised
+// elevation pixels above the surface. The shadow is built from
+// layers of translucent rounded rectangles, each larger and
+// fainter than the previous. The shadow is clipped to bounds.
+func drawShadow(gtx layout.Context, rr clip.RRect, elevation float32, bounds image.Rectangle) {
+	if elevation <= 0 {
+		return
+	}
+	defer op.Save(gtx.Ops).Load()
+	clip.Rect(bounds).Add(gtx.Ops)
+	const layers = 4
+	off := f32.Pt(0, elevation*.5)
+	for i := layers; i > 0; i-- {
+		spread := elevation * float32(i) / layers
+		sr := clip.RRect{
+			Rect: f32.Rectangle{
+				Min: rr.Rect.Min.Sub(f32.Pt(spread, spread)).Add(off),
+				Max: rr.Rect.Max.Add(f32.Pt(spread, spread)).Add(off),
+			},
+			SE: rr.SE + spread,
+			SW: rr.SW + spread,
+			NW: rr.NW + spread,
+			NE: rr.NE + spread,
+		}
+		paint.FillShape(gtx.Ops, color.NRGBA{A: 0x12}, sr.Op(gtx.Ops))
+	}
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/font/gofont"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+func TestShadowRoom(t *testing.T) {
+	var ops op.Ops
+	th := NewTheme(gofont.Collection())
+	gtx := layout.Context{
+		Ops:         &ops,
+		Constraints: layout.Constraints{Max: image.Pt(500, 500)},
+	}
+	content := func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: image.Pt(100, 50)}
+	}
+	card := Card(th)
+	card.Elevation = unit.Px(4)
+	card.Inset = layout.Inset{}
+	// The shadow takes 2px above, 4px at the sides and 6px below.
+	want := image.Pt(100+4+4, 50+2+6)
+	if got := card.Layout(gtx, content).Size; got != want {
+		t.Errorf("card: got size %v, expected %v", got, want)
+	}
+	button := ButtonLayout(th, new(widget.Clickable))
+	button.Elevation = unit.Px(4)
+	if got := button.Layout(gtx, content).Size; got != want {
+		t.Errorf("button: got size %v, expected %v", got, want)
+	}
+}
@@ -19,18 +19,20 @@ import (
 // Slider is for selecting a value in a range.
 func Slider(th *Theme, float *widget.Float, min, max float32) SliderStyle {
 	return SliderStyle{
-		Min:        min,
-		Max:        max,
-		Color:      th.Palette.ContrastBg,
-		Float:      float,
-		FingerSize: th.FingerSize,
+		Min:           min,
+		Max:           max,
+		Color:         th.Palette.ContrastBg,
+		DisabledColor: th.Palette.Disabled,
+		Float:         float,
+		FingerSize:    th.FingerSize,
 	}
 }
 
 type SliderStyle struct {
-	Min, Max float32
-	Color    color.NRGBA
-	Float    *widget.Float
+	Min, Max      float32
+	Color         color.NRGBA
+	DisabledColor color.NRGBA
+	Float         *widget.Float
 
 	FingerSize unit.Value
 }
@@ -58,10 +60,7 @@ func (s SliderStyle) Layout(gtx layout.Context) layout.Dimensions {
 	thumbPos := thumbRadius + int(s.Float.Pos())
 	st.Load()
 
-	color := s.Color
-	if gtx.Queue == nil {
-		color = f32color.Disabled(color)
-	}
+	color := blendDisabledColor(gtx.Queue == nil, s.DisabledColor, s.Color)
 
 	// Draw track before thumb.
 	st = op.Save(gtx.Ops)
@@ -83,8 +82,17 @@ func (s SliderStyle) Layout(gtx layout.Context) layout.Dimensions {
 	paint.Fill(gtx.Ops, f32color.MulAlpha(color, 96))
 	st.Load()
 
-	// Draw thumb.
+	// Draw a halo around the thumb while dragging.
 	pt := axis.Convert(image.Pt(thumbPos, sizeCross/2))
+	if s.Float.Dragging() {
+		paint.FillShape(gtx.Ops, f32color.MulAlpha(color, 0x40),
+			clip.Circle{
+				Center: f32.Point{X: float32(pt.X), Y: float32(pt.Y)},
+				Radius: float32(thumbRadius) * 2.5,
+			}.Op(gtx.Ops))
+	}
+
+	// Draw thumb.
 	paint.FillShape(gtx.Ops, color,
 		clip.Circle{
 			Center: f32.Point{X: float32(pt.X), Y: float32(pt.Y)},
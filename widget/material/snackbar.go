@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// SnackbarStyle defines the presentation of the messages of a
+// Snackbar, which slide in from the bottom of the window.
+type SnackbarStyle struct {
+	// Color is the message text color.
+	Color        color.NRGBA
+	Background   color.NRGBA
+	CornerRadius unit.Value
+	Elevation    unit.Value
+	Font         text.Font
+	TextSize     unit.Value
+	Inset        layout.Inset
+	// Margin is the space between the message and the window edges.
+	Margin layout.Inset
+	// MaxWidth is the maximum width of a message.
+	MaxWidth unit.Value
+	// Action is the style of the action button. Its text is set
+	// from the current message.
+	Action   ButtonStyle
+	Snackbar *widget.Snackbar
+	shaper   text.Shaper
+}
+
+// slideDuration is the duration of the slide in and out of a
+// snackbar message.
+const slideDuration = 250 * time.Millisecond
+
+// Snackbar returns the presentation of the messages of state.
+func Snackbar(th *Theme, state *widget.Snackbar) SnackbarStyle {
+	action := TextButton(th, &state.Action, "")
+	action.Color = th.Palette.SecondaryContainer
+	action.FocusColor = th.Palette.SecondaryContainer
+	return SnackbarStyle{
+		Color:        th.Palette.Bg,
+		Background:   th.Palette.Fg,
+		CornerRadius: unit.Dp(4),
+		Elevation:    unit.Dp(6),
+		TextSize:     th.TextStyleSize(TextStyleBody2),
+		Inset: layout.Inset{
+			Top: unit.Dp(14), Bottom: unit.Dp(14),
+			Left: unit.Dp(16), Right: unit.Dp(8),
+		},
+		Margin:   layout.UniformInset(unit.Dp(8)),
+		MaxWidth: unit.Dp(600),
+		Action:   action,
+		Snackbar: state,
+		shaper:   th.Shaper,
+	}
+}
+
+// Layout the current message, if any, at the bottom center of the
+// maximum constraints.
+func (s SnackbarStyle) Layout(gtx layout.Context) layout.Dimensions {
+	bounds := gtx.Constraints.Max
+	state := s.Snackbar
+	if _, ok := state.Current(); !ok {
+		return layout.Dimensions{Size: bounds}
+	}
+	mgtx := gtx
+	mgtx.Constraints.Min = image.Point{}
+	margin := image.Pt(gtx.Px(s.Margin.Left)+gtx.Px(s.Margin.Right), gtx.Px(s.Margin.Top)+gtx.Px(s.Margin.Bottom))
+	mgtx.Constraints.Max = bounds.Sub(margin)
+	if max := gtx.Px(s.MaxWidth); mgtx.Constraints.Max.X > max {
+		mgtx.Constraints.Max.X = max
+	}
+	macro := op.Record(gtx.Ops)
+	dims := state.Layout(mgtx, s.layoutMessage)
+	call := macro.Stop()
+	if dims.Size == (image.Point{}) {
+		return layout.Dimensions{Size: bounds}
+	}
+
+	// Slide in, then out before the timeout.
+	p := float32(1)
+	age, timeout := state.Age(), state.TimeoutDuration()
+	switch {
+	case age < slideDuration:
+		p = float32(age.Seconds() / slideDuration.Seconds())
+		op.InvalidateOp{}.Add(gtx.Ops)
+	case age > timeout-slideDuration:
+		p = float32((timeout - age).Seconds() / slideDuration.Seconds())
+		op.InvalidateOp{}.Add(gtx.Ops)
+	}
+	// Ease out.
+	p = 1 - (1-p)*(1-p)
+	hidden := dims.Size.Y + gtx.Px(s.Margin.Bottom)
+	pos := image.Pt(
+		(bounds.X-dims.Size.X)/2,
+		bounds.Y-gtx.Px(s.Margin.Bottom)-dims.Size.Y+int(float32(hidden)*(1-p)),
+	)
+	defer op.Save(gtx.Ops).Load()
+	op.Offset(layout.FPt(pos)).Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	return layout.Dimensions{Size: bounds}
+}
+
+// layoutMessage lays out the background, text and action button of
+// the current message.
+func (s SnackbarStyle) layoutMessage(gtx layout.Context) layout.Dimensions {
+	msg, _ := s.Snackbar.Current()
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			size := gtx.Constraints.Min
+			r := f32.Rectangle{Max: layout.FPt(size)}
+			rr := clip.UniformRRect(r, float32(gtx.Px(s.CornerRadius)))
+			// Confine the shadow to the margin around the message.
+			bounds := image.Rectangle{
+				Min: image.Pt(-gtx.Px(s.Margin.Left), -gtx.Px(s.Margin.Top)),
+				Max: size.Add(image.Pt(gtx.Px(s.Margin.Right), gtx.Px(s.Margin.Bottom))),
+			}
+			drawShadow(gtx, rr, float32(gtx.Px(s.Elevation)), bounds)
+			paint.FillShape(gtx.Ops, s.Background, rr.Op(gtx.Ops))
+			return layout.Dimensions{Size: gtx.Constraints.Min}
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+				layout.Flexed(1, func(gtx layout.Context) layout.Dimensions {
+					gtx.Constraints.Min.X = 0
+					in := s.Inset
+					if msg.Action == "" {
+						in.Right = in.Left
+					}
+					return in.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+						paint.ColorOp{Color: s.Color}.Add(gtx.Ops)
+						return widget.Label{}.Layout(gtx, s.shaper, s.Font, s.TextSize, msg.Text)
+					})
+				}),
+				layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+					if msg.Action == "" {
+						return layout.Dimensions{}
+					}
+					btn := s.Action
+					btn.Text = msg.Action
+					return btn.Layout(gtx)
+				}),
+			)
+		}),
+	)
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// SplitStyle defines the presentation of two resizable panes
+// separated by a divider line.
+type SplitStyle struct {
+	Split   *widget.Split
+	Divider DividerStyle
+	// Inset is the space on each side of the divider line that
+	// responds to dragging.
+	Inset unit.Value
+}
+
+// Split returns resizable panes for state.
+func Split(th *Theme, state *widget.Split) SplitStyle {
+	return SplitStyle{
+		Split:   state,
+		Divider: Divider(th),
+		Inset:   unit.Dp(3),
+	}
+}
+
+// Layout the panes over the maximum constraints.
+func (s SplitStyle) Layout(gtx layout.Context, first, second layout.Widget) layout.Dimensions {
+	d := s.Divider
+	d.Inset = layout.Inset{}
+	if s.Split.Axis == layout.Horizontal {
+		d.Axis = layout.Vertical
+		d.Inset.Left, d.Inset.Right = s.Inset, s.Inset
+	} else {
+		d.Axis = layout.Horizontal
+		d.Inset.Top, d.Inset.Bottom = s.Inset, s.Inset
+	}
+	return s.Split.Layout(gtx, first, d.Layout, second)
+}
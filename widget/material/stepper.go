@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image/color"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// StepperStyle defines the presentation of a numeric input with
+// buttons for incrementing and decrementing its value.
+type StepperStyle struct {
+	Editor    EditorStyle
+	Increment IconButtonStyle
+	Decrement IconButtonStyle
+	Stepper   *widget.Stepper
+}
+
+// Stepper returns a numeric input for state, with the buttons
+// stacked after the text.
+func Stepper(th *Theme, state *widget.Stepper) StepperStyle {
+	button := func(b *widget.Clickable, icon *widget.Icon, desc string) IconButtonStyle {
+		s := IconButton(th, b, icon)
+		s.Background = color.NRGBA{}
+		s.Color = th.Palette.Fg
+		s.Size = unit.Dp(16)
+		s.Inset = layout.UniformInset(unit.Dp(2))
+		s.CenteredInk = true
+		s.Description = desc
+		return s
+	}
+	editor := Editor(th, &state.Editor, "")
+	// EditorStyle.Layout copies its hint to the editor, replacing the
+	// numeric hint set by widget.Stepper.
+	if editor.InputHint == key.HintAny {
+		editor.InputHint = key.HintNumeric
+	}
+	return StepperStyle{
+		Editor:    editor,
+		Increment: button(&state.Increment, th.Icon.ExpandLess, "Increment"),
+		Decrement: button(&state.Decrement, th.Icon.ExpandMore, "Decrement"),
+		Stepper:   state,
+	}
+}
+
+func (s StepperStyle) Layout(gtx layout.Context) layout.Dimensions {
+	return s.Stepper.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			layout.Flexed(1, s.Editor.Layout),
+			layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+					layout.Rigid(s.Increment.Layout),
+					layout.Rigid(s.Decrement.Layout),
+				)
+			}),
+		)
+	})
+}
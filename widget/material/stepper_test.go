@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/font/gofont"
+	"gioui.org/io/key"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget"
+)
+
+func TestStepperInputHint(t *testing.T) {
+	var (
+		ops   op.Ops
+		r     router.Router
+		state widget.Stepper
+	)
+	th := NewTheme(gofont.Collection())
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(200, 50)),
+	}
+	state.Editor.Focus()
+	for i := 0; i < 2; i++ {
+		ops.Reset()
+		Stepper(th, &state).Layout(gtx)
+		r.Frame(&ops)
+	}
+	if hint, _ := r.TextInputHint(); hint != key.HintNumeric {
+		t.Errorf("got input hint %v, expected %v", hint, key.HintNumeric)
+	}
+}
@@ -5,10 +5,12 @@ package material
 import (
 	"image"
 	"image/color"
+	"time"
 
 	"gioui.org/f32"
 	"gioui.org/internal/f32color"
 	"gioui.org/io/pointer"
+	"gioui.org/io/semantic"
 	"gioui.org/layout"
 	"gioui.org/op"
 	"gioui.org/op/clip"
@@ -17,19 +19,29 @@ import (
 	"gioui.org/widget"
 )
 
+// toggleDuration is the duration of the transition between the
+// states of a selection control.
+const toggleDuration = 150 * time.Millisecond
+
 type SwitchStyle struct {
 	Color struct {
 		Enabled  color.NRGBA
 		Disabled color.NRGBA
 		Track    color.NRGBA
 	}
-	Switch *widget.Bool
+	// DisabledColor is the color that the thumb fades towards when
+	// the switch is disabled.
+	DisabledColor color.NRGBA
+	Switch        *widget.Bool
+	// Description describes the switch to accessibility services.
+	Description string
 }
 
 // Switch is for selecting a boolean value.
 func Switch(th *Theme, swtch *widget.Bool) SwitchStyle {
 	sw := SwitchStyle{
-		Switch: swtch,
+		Switch:        swtch,
+		DisabledColor: th.Palette.Disabled,
 	}
 	sw.Color.Enabled = th.Palette.ContrastBg
 	sw.Color.Disabled = th.Palette.Bg
@@ -43,6 +55,11 @@ func (s SwitchStyle) Layout(gtx layout.Context) layout.Dimensions {
 	trackHeight := gtx.Px(unit.Dp(16))
 	thumbSize := gtx.Px(unit.Dp(20))
 	trackOff := float32(thumbSize-trackHeight) * .5
+	describe(gtx, image.Pt(trackWidth, thumbSize), description{
+		class:    semantic.Switch,
+		desc:     s.Description,
+		selected: s.Switch.Value,
+	})
 
 	// Draw track.
 	stack := op.Save(gtx.Ops)
@@ -55,9 +72,7 @@ func (s SwitchStyle) Layout(gtx layout.Context) layout.Dimensions {
 	if s.Switch.Value {
 		col = s.Color.Enabled
 	}
-	if gtx.Queue == nil {
-		col = f32color.Disabled(col)
-	}
+	col = blendDisabledColor(gtx.Queue == nil, s.DisabledColor, col)
 	trackColor := s.Color.Track
 	op.Offset(f32.Point{Y: trackOff}).Add(gtx.Ops)
 	clip.UniformRRect(trackRect, trackCorner).Add(gtx.Ops)
@@ -65,33 +80,40 @@ func (s SwitchStyle) Layout(gtx layout.Context) layout.Dimensions {
 	paint.PaintOp{}.Add(gtx.Ops)
 	stack.Load()
 
+	// Animate the thumb towards the position of the current value.
+	progress := float32(1)
+	if dt := gtx.Now.Sub(s.Switch.ChangeTime()); dt < toggleDuration {
+		progress = float32(dt.Seconds() / toggleDuration.Seconds())
+		op.InvalidateOp{}.Add(gtx.Ops)
+	}
+	if !s.Switch.Value {
+		progress = 1 - progress
+	}
+	thumbX := float32(trackWidth-thumbSize) * progress
+
 	// Draw thumb ink.
 	stack = op.Save(gtx.Ops)
 	inkSize := gtx.Px(unit.Dp(44))
 	rr := float32(inkSize) * .5
 	inkOff := f32.Point{
-		X: float32(trackWidth)*.5 - rr,
+		X: thumbX + float32(thumbSize)*.5 - rr,
 		Y: -rr + float32(trackHeight)*.5 + trackOff,
 	}
 	op.Offset(inkOff).Add(gtx.Ops)
 	gtx.Constraints.Min = image.Pt(inkSize, inkSize)
 	clip.UniformRRect(f32.Rectangle{Max: layout.FPt(gtx.Constraints.Min)}, rr).Add(gtx.Ops)
-	for _, p := range s.Switch.History() {
-		drawInk(gtx, p)
-	}
+	// Center the ripple around the thumb.
+	inkSet{duration: defaultInkDuration, centered: true}.draw(gtx, s.Switch.History())
 	stack.Load()
 
 	// Compute thumb offset and color.
 	stack = op.Save(gtx.Ops)
-	if s.Switch.Value {
-		off := trackWidth - thumbSize
-		op.Offset(f32.Point{X: float32(off)}).Add(gtx.Ops)
-	}
+	op.Offset(f32.Point{X: thumbX}).Add(gtx.Ops)
 
 	thumbRadius := float32(thumbSize) / 2
 
 	// Draw hover.
-	if s.Switch.Hovered() {
+	if s.Switch.Hovered() || s.Switch.Focused() {
 		r := 1.7 * thumbRadius
 		background := f32color.MulAlpha(s.Color.Enabled, 70)
 		paint.FillShape(gtx.Ops, background,
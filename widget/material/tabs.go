@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/internal/f32color"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+	"gioui.org/widget/anim"
+)
+
+// TabsStyle defines the presentation of a horizontally scrollable row
+// of tabs with an indicator below the selected tab.
+type TabsStyle struct {
+	Labels []string
+	// Color is the label color of unselected tabs.
+	Color color.NRGBA
+	// SelectedColor is the label color of the selected tab.
+	SelectedColor color.NRGBA
+	// IndicatorColor is the color of the selected tab indicator.
+	IndicatorColor  color.NRGBA
+	IndicatorHeight unit.Value
+	Font            text.Font
+	TextSize        unit.Value
+	InkColor        color.NRGBA
+	DisabledColor   color.NRGBA
+	Inset           layout.Inset
+	Tabs            *widget.Tabs
+	shaper          text.Shaper
+}
+
+type tabChild struct {
+	call op.CallOp
+	dims layout.Dimensions
+}
+
+// indicatorDuration is the duration of the indicator movement
+// between tabs.
+const indicatorDuration = 250 * time.Millisecond
+
+// Tabs returns a row of tabs with a label each.
+func Tabs(th *Theme, tabs *widget.Tabs, labels ...string) TabsStyle {
+	return TabsStyle{
+		Labels:          labels,
+		Color:           f32color.MulAlpha(th.Palette.Fg, 0xbb),
+		SelectedColor:   th.Palette.ContrastBg,
+		IndicatorColor:  th.Palette.ContrastBg,
+		IndicatorHeight: unit.Dp(2),
+		DisabledColor:   th.Palette.Disabled,
+		TextSize:        th.TextStyleSize(TextStyleButton),
+		Inset: layout.Inset{
+			Top: unit.Dp(12), Bottom: unit.Dp(12),
+			Left: unit.Dp(16), Right: unit.Dp(16),
+		},
+		Tabs:   tabs,
+		shaper: th.Shaper,
+	}
+}
+
+func (t TabsStyle) Layout(gtx layout.Context) layout.Dimensions {
+	state := t.Tabs
+	n := len(t.Labels)
+	if n == 0 {
+		return layout.Dimensions{Size: gtx.Constraints.Min}
+	}
+	// Lay out every tab up front, for positioning the indicator.
+	var buf [8]tabChild
+	tabs := buf[:0]
+	if n > len(buf) {
+		tabs = make([]tabChild, 0, n)
+	}
+	tgtx := gtx
+	tgtx.Constraints.Min = image.Point{}
+	for i := range t.Labels {
+		macro := op.Record(gtx.Ops)
+		dims := t.layoutTab(tgtx, i)
+		tabs = append(tabs, tabChild{call: macro.Stop(), dims: dims})
+	}
+	state.List.Axis = layout.Horizontal
+	dims := state.List.Layout(gtx, n, func(gtx layout.Context, i int) layout.Dimensions {
+		tabs[i].call.Add(gtx.Ops)
+		return tabs[i].dims
+	})
+
+	// extent returns the start and end of tab i relative to the
+	// start of the visible tabs.
+	pos := state.List.Position
+	extent := func(i int) (float32, float32) {
+		if i < 0 || i >= n {
+			i = 0
+		}
+		start := -pos.Offset
+		for j := pos.First; j > i; j-- {
+			start -= tabs[j-1].dims.Size.X
+		}
+		for j := pos.First; j < i; j++ {
+			start += tabs[j].dims.Size.X
+		}
+		return float32(start), float32(start + tabs[i].dims.Size.X)
+	}
+	start, end := extent(state.Selected)
+	if a := (anim.Animation{Start: state.ChangeTime(), Duration: indicatorDuration, Easing: anim.EaseOut}); !a.Finished(gtx.Now) {
+		p := a.Progress(gtx)
+		s0, e0 := extent(state.Previous())
+		start, end = s0+(start-s0)*p, e0+(end-e0)*p
+	}
+	h := float32(gtx.Px(t.IndicatorHeight))
+	y := float32(dims.Size.Y)
+	col := blendDisabledColor(gtx.Queue == nil, t.DisabledColor, t.IndicatorColor)
+	defer op.Save(gtx.Ops).Load()
+	clip.Rect{Max: dims.Size}.Add(gtx.Ops)
+	paint.FillShape(gtx.Ops, col, clip.Rect{
+		Min: image.Pt(int(start), int(y-h)),
+		Max: image.Pt(int(end), int(y)),
+	}.Op())
+	return dims
+}
+
+// layoutTab lays out the label, background and input handler of tab i.
+func (t TabsStyle) layoutTab(gtx layout.Context, i int) layout.Dimensions {
+	state := t.Tabs
+	return layout.Stack{}.Layout(gtx,
+		layout.Expanded(func(gtx layout.Context) layout.Dimensions {
+			defer op.Save(gtx.Ops).Load()
+			clip.Rect{Max: gtx.Constraints.Min}.Add(gtx.Ops)
+			if state.Hovered(i) && gtx.Queue != nil {
+				paint.FillShape(gtx.Ops, f32color.MulAlpha(t.SelectedColor, 0x14), clip.Rect{Max: gtx.Constraints.Min}.Op())
+			}
+			drawInks(gtx, state.History(i), t.InkColor, defaultInkDuration)
+			return state.Layout(gtx, i)
+		}),
+		layout.Stacked(func(gtx layout.Context) layout.Dimensions {
+			return t.Inset.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				col := t.Color
+				if i == state.Selected {
+					col = t.SelectedColor
+				}
+				paint.ColorOp{Color: blendDisabledColor(gtx.Queue == nil, t.DisabledColor, col)}.Add(gtx.Ops)
+				return widget.Label{MaxLines: 1}.Layout(gtx, t.shaper, t.Font, t.TextSize, t.Labels[i])
+			})
+		}),
+	)
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/internal/f32color"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// TextFieldStyle defines the presentation of an editor with a
+// floating label, an underline and optional helper text.
+type TextFieldStyle struct {
+	// Label is shown inside the empty field, and floats above the
+	// text when the field is focused or has text.
+	Label string
+	// Helper is optional text shown below the field.
+	Helper string
+	// Error, if set, is shown in place of Helper, and colors the
+	// field with ErrorColor.
+	Error string
+	// LabelColor is the color of the label and helper text.
+	LabelColor color.NRGBA
+	// UnderlineColor is the color of the underline when the field
+	// is not focused.
+	UnderlineColor color.NRGBA
+	// FocusColor is the color of the underline and the floating
+	// label when the field is focused.
+	FocusColor color.NRGBA
+	ErrorColor color.NRGBA
+	// HelperSize is the text size of the floating label and the
+	// helper text.
+	HelperSize unit.Value
+	Editor     EditorStyle
+}
+
+// labelDuration is the duration of the floating label animation.
+const labelDuration = 150 * time.Millisecond
+
+// TextField returns a text field for editor with a floating label.
+func TextField(th *Theme, editor *widget.Editor, label string) TextFieldStyle {
+	return TextFieldStyle{
+		Label:          label,
+		LabelColor:     f32color.MulAlpha(th.Palette.Fg, 0xbb),
+		UnderlineColor: f32color.MulAlpha(th.Palette.Fg, 0x88),
+		FocusColor:     th.Palette.ContrastBg,
+		ErrorColor:     th.Palette.Error,
+		HelperSize:     th.TextStyleSize(TextStyleCaption),
+		Editor:         Editor(th, editor, ""),
+	}
+}
+
+func (t TextFieldStyle) Layout(gtx layout.Context) layout.Dimensions {
+	ed := t.Editor.Editor
+	focused := ed.Focused()
+	float := float32(0)
+	if focused || ed.Len() > 0 {
+		float = 1
+	}
+	if dt := gtx.Now.Sub(ed.FocusTime()); dt < labelDuration && ed.Len() == 0 {
+		p := float32(dt.Seconds() / labelDuration.Seconds())
+		if focused {
+			float = p
+		} else {
+			float = 1 - p
+		}
+		op.InvalidateOp{}.Add(gtx.Ops)
+	}
+	if float < 1 {
+		// Don't show the hint below the resting label.
+		t.Editor.Hint = ""
+	}
+	t.Editor.label = t.Label
+	accent := t.FocusColor
+	if t.Error != "" {
+		accent = t.ErrorColor
+	}
+	disabled := gtx.Queue == nil
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			// Measure the label at full size.
+			macro := op.Record(gtx.Ops)
+			lgtx := gtx
+			lgtx.Constraints.Min = image.Point{}
+			labelCol := t.LabelColor
+			if t.Error != "" || focused && float == 1 {
+				labelCol = accent
+			}
+			paint.ColorOp{Color: blendDisabledColor(disabled, t.Editor.DisabledColor, labelCol)}.Add(gtx.Ops)
+			ldims := widget.Label{MaxLines: 1}.Layout(lgtx, t.Editor.shaper, t.Editor.Font, t.Editor.TextSize, t.Label)
+			label := macro.Stop()
+
+			scale := float32(gtx.Px(t.HelperSize)) / float32(gtx.Px(t.Editor.TextSize))
+			top := int(float32(ldims.Size.Y)*scale) + gtx.Px(unit.Dp(4))
+			bottom := gtx.Px(unit.Dp(8))
+			gtx.Constraints.Min.Y = 0
+			dims := layout.Inset{Top: unit.Px(float32(top)), Bottom: unit.Px(float32(bottom))}.Layout(gtx, t.Editor.Layout)
+
+			// Draw the label, moving and shrinking it from the
+			// editor text to the top of the field.
+			if t.Label != "" {
+				stack := op.Save(gtx.Ops)
+				s := 1 + (scale-1)*float
+				y := float32(top) * (1 - float)
+				op.Affine(f32.Affine2D{}.Scale(f32.Point{}, f32.Pt(s, s)).Offset(f32.Pt(0, y))).Add(gtx.Ops)
+				label.Add(gtx.Ops)
+				stack.Load()
+			}
+
+			// Draw the underline.
+			width := gtx.Px(unit.Dp(1))
+			col := t.UnderlineColor
+			if t.Error != "" {
+				col = accent
+			}
+			if focused {
+				width = gtx.Px(unit.Dp(2))
+				col = accent
+			}
+			line := image.Rect(0, dims.Size.Y-width, dims.Size.X, dims.Size.Y)
+			paint.FillShape(gtx.Ops, blendDisabledColor(disabled, t.Editor.DisabledColor, col), clip.Rect(line).Op())
+			return dims
+		}),
+		layout.Rigid(func(gtx layout.Context) layout.Dimensions {
+			txt, col := t.Helper, t.LabelColor
+			if t.Error != "" {
+				txt, col = t.Error, t.ErrorColor
+			}
+			if txt == "" {
+				return layout.Dimensions{}
+			}
+			return layout.Inset{Top: unit.Dp(4)}.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+				paint.ColorOp{Color: blendDisabledColor(disabled, t.Editor.DisabledColor, col)}.Add(gtx.Ops)
+				return widget.Label{}.Layout(gtx, t.Editor.shaper, t.Editor.Font, t.HelperSize, txt)
+			})
+		}),
+	)
+}
@@ -29,17 +29,106 @@ type Palette struct {
 	// ContrastFg is a color suitable for content drawn on top of
 	// ContrastBg.
 	ContrastFg color.NRGBA
+
+	// SecondaryContainer is a color of lower emphasis than ContrastBg
+	// for filling widgets such as tonal buttons.
+	SecondaryContainer color.NRGBA
+
+	// OnSecondaryContainer is a color suitable for content drawn on top
+	// of SecondaryContainer.
+	OnSecondaryContainer color.NRGBA
+
+	// Secondary is an accent color for less prominent widgets than
+	// those using ContrastBg.
+	Secondary color.NRGBA
+
+	// OnSecondary is a color suitable for content drawn on top of
+	// Secondary.
+	OnSecondary color.NRGBA
+
+	// Surface is the color of raised containers such as cards and
+	// menus.
+	Surface color.NRGBA
+
+	// OnSurface is a color suitable for content drawn on top of
+	// Surface.
+	OnSurface color.NRGBA
+
+	// Error is the color of error indications.
+	Error color.NRGBA
+
+	// OnError is a color suitable for content drawn on top of Error.
+	OnError color.NRGBA
+
+	// Outline is the color of borders and dividers.
+	Outline color.NRGBA
+
+	// Disabled is the color that disabled widgets fade towards. If
+	// Disabled is the zero color, disabled widgets are desaturated
+	// instead.
+	Disabled color.NRGBA
 }
 
+var (
+	// LightPalette is the default palette of NewTheme.
+	LightPalette = Palette{
+		Fg:         rgb(0x000000),
+		Bg:         rgb(0xffffff),
+		ContrastBg: rgb(0x3f51b5),
+		ContrastFg: rgb(0xffffff),
+
+		SecondaryContainer:   rgb(0xc5cae9),
+		OnSecondaryContainer: rgb(0x1a237e),
+		Secondary:            rgb(0x00897b),
+		OnSecondary:          rgb(0xffffff),
+
+		Surface:   rgb(0xffffff),
+		OnSurface: rgb(0x000000),
+		Error:     rgb(0xb00020),
+		OnError:   rgb(0xffffff),
+		Outline:   rgb(0x757575),
+		Disabled:  rgb(0x9e9e9e),
+	}
+
+	// DarkPalette is a dark variant of LightPalette.
+	DarkPalette = Palette{
+		Fg:         rgb(0xe0e0e0),
+		Bg:         rgb(0x121212),
+		ContrastBg: rgb(0x9fa8da),
+		ContrastFg: rgb(0x000000),
+
+		SecondaryContainer:   rgb(0x303f9f),
+		OnSecondaryContainer: rgb(0xc5cae9),
+		Secondary:            rgb(0x80cbc4),
+		OnSecondary:          rgb(0x000000),
+
+		Surface:   rgb(0x1e1e1e),
+		OnSurface: rgb(0xe0e0e0),
+		Error:     rgb(0xcf6679),
+		OnError:   rgb(0x000000),
+		Outline:   rgb(0x8e8e8e),
+		Disabled:  rgb(0x616161),
+	}
+)
+
 type Theme struct {
+	// Shaper lays out text. The shaper of NewTheme is a *text.Cache,
+	// whose SetFallbacks method registers fallback fonts.
 	Shaper text.Shaper
 	Palette
 	TextSize unit.Value
-	Icon     struct {
+	// TypeScale holds the sizes of the text styles relative to
+	// TextSize.
+	TypeScale TypeScale
+	Icon      struct {
 		CheckBoxChecked   *widget.Icon
 		CheckBoxUnchecked *widget.Icon
 		RadioChecked      *widget.Icon
 		RadioUnchecked    *widget.Icon
+		Close             *widget.Icon
+		ExpandLess        *widget.Icon
+		ExpandMore        *widget.Icon
+		MoreVert          *widget.Icon
 	}
 
 	// FingerSize is the minimum touch target size.
@@ -50,18 +139,18 @@ func NewTheme(fontCollection []text.FontFace) *Theme {
 	t := &Theme{
 		Shaper: text.NewCache(fontCollection),
 	}
-	t.Palette = Palette{
-		Fg:         rgb(0x000000),
-		Bg:         rgb(0xffffff),
-		ContrastBg: rgb(0x3f51b5),
-		ContrastFg: rgb(0xffffff),
-	}
+	t.Palette = LightPalette
 	t.TextSize = unit.Sp(16)
+	t.TypeScale = defaultTypeScale
 
 	t.Icon.CheckBoxChecked = mustIcon(widget.NewIcon(icons.ToggleCheckBox))
 	t.Icon.CheckBoxUnchecked = mustIcon(widget.NewIcon(icons.ToggleCheckBoxOutlineBlank))
 	t.Icon.RadioChecked = mustIcon(widget.NewIcon(icons.ToggleRadioButtonChecked))
 	t.Icon.RadioUnchecked = mustIcon(widget.NewIcon(icons.ToggleRadioButtonUnchecked))
+	t.Icon.Close = mustIcon(widget.NewIcon(icons.NavigationCancel))
+	t.Icon.ExpandLess = mustIcon(widget.NewIcon(icons.NavigationExpandLess))
+	t.Icon.ExpandMore = mustIcon(widget.NewIcon(icons.NavigationExpandMore))
+	t.Icon.MoreVert = mustIcon(widget.NewIcon(icons.NavigationMoreVert))
 
 	// 38dp is on the lower end of possible finger size.
 	t.FingerSize = unit.Dp(38)
@@ -69,6 +158,9 @@ func NewTheme(fontCollection []text.FontFace) *Theme {
 	return t
 }
 
+// WithPalette returns a copy of the theme with its palette replaced
+// by p. Styles constructed from the copy use the new palette; styles
+// constructed earlier keep their colors.
 func (t Theme) WithPalette(p Palette) Theme {
 	t.Palette = p
 	return t
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"gioui.org/f32"
+	"gioui.org/internal/f32color"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+// TooltipStyle defines the presentation of a short text label shown
+// above a widget while it is hovered or focused.
+type TooltipStyle struct {
+	Text         string
+	Font         text.Font
+	TextSize     unit.Value
+	Color        color.NRGBA
+	Background   color.NRGBA
+	CornerRadius unit.Value
+	Inset        layout.Inset
+	// Gap is the distance between the tooltip and the widget.
+	Gap unit.Value
+	// Bounds is the area the tooltip is kept within, relative to
+	// the widget. It is typically the window bounds offset by the
+	// position of the widget. The empty Bounds only keeps the tooltip
+	// within the horizontal space of the maximum constraints.
+	Bounds  image.Rectangle
+	Tooltip *widget.Tooltip
+
+	shaper text.Shaper
+}
+
+// Tooltip returns a tooltip showing txt.
+func Tooltip(th *Theme, tip *widget.Tooltip, txt string) TooltipStyle {
+	return TooltipStyle{
+		Text:         txt,
+		TextSize:     th.TextStyleSize(TextStyleCaption),
+		Color:        th.Palette.Bg,
+		Background:   f32color.MulAlpha(th.Palette.Fg, 0xe6),
+		CornerRadius: unit.Dp(4),
+		Inset: layout.Inset{
+			Top: unit.Dp(4), Bottom: unit.Dp(4),
+			Left: unit.Dp(8), Right: unit.Dp(8),
+		},
+		Gap:     unit.Dp(4),
+		Tooltip: tip,
+		shaper:  th.Shaper,
+	}
+}
+
+// Layout lays out w and, when the tooltip is visible, draws the
+// tooltip centered above it on top of all other content. The
+// tooltip is kept within Bounds, and placed below w when there is no
+// room above it.
+func (t TooltipStyle) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
+	dims := t.Tooltip.Layout(gtx, w)
+	if !t.Tooltip.Visible() || t.Text == "" {
+		return dims
+	}
+	macro := op.Record(gtx.Ops)
+	cgtx := gtx
+	cgtx.Constraints.Min = image.Point{}
+	tdims := t.Inset.Layout(cgtx, func(gtx layout.Context) layout.Dimensions {
+		paint.ColorOp{Color: t.Color}.Add(gtx.Ops)
+		return widget.Label{MaxLines: 1}.Layout(gtx, t.shaper, t.Font, t.TextSize, t.Text)
+	})
+	content := macro.Stop()
+
+	pos := t.place(gtx, dims.Size, tdims.Size)
+	macro = op.Record(gtx.Ops)
+	op.Offset(layout.FPt(pos)).Add(gtx.Ops)
+	rr := float32(gtx.Px(t.CornerRadius))
+	r := f32.Rectangle{Max: layout.FPt(tdims.Size)}
+	paint.FillShape(gtx.Ops, t.Background, clip.UniformRRect(r, rr).Op(gtx.Ops))
+	content.Add(gtx.Ops)
+	op.Defer(gtx.Ops, macro.Stop())
+	return dims
+}
+
+// place returns the position of a tooltip of size tip, relative to a
+// widget of size.
+func (t TooltipStyle) place(gtx layout.Context, size, tip image.Point) image.Point {
+	bounds := t.Bounds
+	if bounds.Empty() {
+		bounds = image.Rect(0, math.MinInt32, gtx.Constraints.Max.X, math.MaxInt32)
+	}
+	x := (size.X - tip.X) / 2
+	if max := bounds.Max.X - tip.X; x > max {
+		x = max
+	}
+	if x < bounds.Min.X {
+		x = bounds.Min.X
+	}
+	gap := gtx.Px(t.Gap)
+	y := -tip.Y - gap
+	if y < bounds.Min.Y {
+		// Flip below the widget, but stay within the bottom edge.
+		y = size.Y + gap
+		if max := bounds.Max.Y - tip.Y; y > max {
+			y = max
+		}
+		if y < bounds.Min.Y {
+			y = bounds.Min.Y
+		}
+	}
+	return image.Pt(x, y)
+}
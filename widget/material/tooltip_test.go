@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/font/gofont"
+	"gioui.org/layout"
+	"gioui.org/unit"
+	"gioui.org/widget"
+)
+
+func TestTooltipPlacement(t *testing.T) {
+	th := NewTheme(gofont.Collection())
+	tip := Tooltip(th, new(widget.Tooltip), "Tip")
+	tip.Gap = unit.Px(4)
+	gtx := layout.Context{
+		Constraints: layout.Constraints{Max: image.Pt(48, 48)},
+	}
+	// The widget is 48x48 and the tooltip 60x20.
+	size, tsize := image.Pt(48, 48), image.Pt(60, 20)
+	for _, tc := range []struct {
+		name   string
+		bounds image.Rectangle
+		want   image.Point
+	}{
+		{
+			name:   "above",
+			bounds: image.Rect(-100, -100, 100, 100),
+			want:   image.Pt(-6, -24),
+		},
+		{
+			name:   "below at the top of the window",
+			bounds: image.Rect(-100, -10, 100, 100),
+			want:   image.Pt(-6, 52),
+		},
+		{
+			name:   "clamped to the left edge",
+			bounds: image.Rect(0, -100, 200, 100),
+			want:   image.Pt(0, -24),
+		},
+		{
+			name:   "clamped to the right edge",
+			bounds: image.Rect(-200, -100, 50, 100),
+			want:   image.Pt(-10, -24),
+		},
+		{
+			name: "maximum constraints",
+			want: image.Pt(0, -24),
+		},
+	} {
+		tip.Bounds = tc.bounds
+		if got := tip.place(gtx, size, tsize); got != tc.want {
+			t.Errorf("%s: got position %v, expected %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package material
+
+import (
+	"gioui.org/unit"
+)
+
+// TextStyle is a typographic role in the type scale of a Theme.
+type TextStyle uint8
+
+const (
+	TextStyleH1 TextStyle = iota
+	TextStyleH2
+	TextStyleH3
+	TextStyleH4
+	TextStyleH5
+	TextStyleH6
+	TextStyleSubtitle1
+	TextStyleSubtitle2
+	TextStyleBody1
+	TextStyleBody2
+	TextStyleButton
+	TextStyleCaption
+	TextStyleOverline
+
+	textStyleCount
+)
+
+// TypeScale holds the text size of each TextStyle, relative to the
+// theme TextSize.
+type TypeScale [textStyleCount]float32
+
+// defaultTypeScale is the Material type scale for a 16sp TextSize.
+var defaultTypeScale = TypeScale{
+	TextStyleH1:        96.0 / 16.0,
+	TextStyleH2:        60.0 / 16.0,
+	TextStyleH3:        48.0 / 16.0,
+	TextStyleH4:        34.0 / 16.0,
+	TextStyleH5:        24.0 / 16.0,
+	TextStyleH6:        20.0 / 16.0,
+	TextStyleSubtitle1: 16.0 / 16.0,
+	TextStyleSubtitle2: 14.0 / 16.0,
+	TextStyleBody1:     16.0 / 16.0,
+	TextStyleBody2:     14.0 / 16.0,
+	TextStyleButton:    14.0 / 16.0,
+	TextStyleCaption:   12.0 / 16.0,
+	TextStyleOverline:  10.0 / 16.0,
+}
+
+// TextStyleSize returns the text size of s in the theme type scale.
+// A zero entry in the scale means the default Material size.
+func (t *Theme) TextStyleSize(s TextStyle) unit.Value {
+	scale := t.TypeScale[s]
+	if scale == 0 {
+		scale = defaultTypeScale[s]
+	}
+	return t.TextSize.Scale(scale)
+}
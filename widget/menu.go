@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+
+	"gioui.org/gesture"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Menu holds the state of a popup menu of items.
+type Menu struct {
+	visible bool
+	anchor  image.Point
+
+	chosen    int
+	hasChosen bool
+
+	dismiss gesture.Click
+	items   []*Clickable
+}
+
+// Open shows the menu at anchor.
+func (m *Menu) Open(anchor image.Point) {
+	m.visible = true
+	m.anchor = anchor
+}
+
+// Close hides the menu.
+func (m *Menu) Close() {
+	m.visible = false
+}
+
+// Visible reports whether the menu is open.
+func (m *Menu) Visible() bool {
+	return m.visible
+}
+
+// Anchor returns the position passed to the most recent Open.
+func (m *Menu) Anchor() image.Point {
+	return m.anchor
+}
+
+// Chosen returns the item clicked since the last call to Chosen,
+// if any.
+func (m *Menu) Chosen() (int, bool) {
+	chosen, ok := m.chosen, m.hasChosen
+	m.hasChosen = false
+	return chosen, ok
+}
+
+// History is the past pointer presses of item i useful for drawing
+// markers.
+func (m *Menu) History(i int) []Press {
+	if i < 0 || i >= len(m.items) {
+		return nil
+	}
+	return m.items[i].History()
+}
+
+// Hovered reports whether a pointer is over item i.
+func (m *Menu) Hovered(i int) bool {
+	return i >= 0 && i < len(m.items) && m.items[i].Hovered()
+}
+
+// Layout adds the handler that closes the menu on presses outside
+// surface, on an area of the minimum constraints. Lay out the menu
+// before its items and surface, and over the area of the window.
+func (m *Menu) Layout(gtx layout.Context, surface image.Rectangle) layout.Dimensions {
+	for _, e := range m.dismiss.Events(gtx) {
+		if e.Type != gesture.TypePress {
+			continue
+		}
+		if !e.Position.In(layout.FRect(surface)) {
+			m.Close()
+		}
+	}
+	defer op.Save(gtx.Ops).Load()
+	pointer.Rect(image.Rectangle{Max: gtx.Constraints.Min}).Add(gtx.Ops)
+	m.dismiss.Add(gtx.Ops)
+	return layout.Dimensions{Size: gtx.Constraints.Min}
+}
+
+// LayoutItem adds the event handler for item i. Clicking an item
+// chooses it and closes the menu.
+func (m *Menu) LayoutItem(gtx layout.Context, i int) layout.Dimensions {
+	for len(m.items) <= i {
+		m.items = append(m.items, &Clickable{Focusable: true})
+	}
+	clk := m.items[i]
+	dims := clk.Layout(gtx)
+	for clk.Clicked() {
+		m.chosen = i
+		m.hasChosen = true
+		m.Close()
+	}
+	return dims
+}
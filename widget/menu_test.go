@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestMenuDismiss(t *testing.T) {
+	var (
+		ops  op.Ops
+		r    router.Router
+		menu Menu
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	surface := image.Rect(10, 10, 50, 30)
+	frame := func() {
+		ops.Reset()
+		menu.Layout(gtx, surface)
+		stack := op.Save(gtx.Ops)
+		op.Offset(layout.FPt(surface.Min)).Add(gtx.Ops)
+		igtx := gtx
+		igtx.Constraints = layout.Exact(surface.Size())
+		menu.LayoutItem(igtx, 0)
+		stack.Load()
+		r.Frame(&ops)
+	}
+	click := func(x, y float32) {
+		r.Queue(
+			pointer.Event{
+				Source:   pointer.Mouse,
+				Buttons:  pointer.ButtonPrimary,
+				Type:     pointer.Press,
+				Position: f32.Pt(x, y),
+			},
+			pointer.Event{
+				Source:   pointer.Mouse,
+				Type:     pointer.Release,
+				Position: f32.Pt(x, y),
+			},
+		)
+		frame()
+	}
+	menu.Open(image.Pt(10, 10))
+	frame()
+	click(20, 20)
+	if i, ok := menu.Chosen(); !ok || i != 0 {
+		t.Errorf("got chosen %d, %v; expected item 0", i, ok)
+	}
+	if menu.Visible() {
+		t.Error("menu visible after choosing an item")
+	}
+	menu.Open(image.Pt(10, 10))
+	click(80, 80)
+	if menu.Visible() {
+		t.Error("menu visible after press outside")
+	}
+	if _, ok := menu.Chosen(); ok {
+		t.Error("item chosen by press outside")
+	}
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/gesture"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Modal holds the state of a modal overlay, which blocks input to the
+// content beneath it while open.
+type Modal struct {
+	// Dismissible enables closing the modal by clicking outside its
+	// content or by pressing Escape.
+	Dismissible bool
+
+	visible bool
+	// changed is set when the modal is opened or closed outside of
+	// Layout.
+	changed    bool
+	changeTime time.Time
+	focus      bool
+
+	scrim   gesture.Click
+	content int
+}
+
+// Open shows the modal.
+func (m *Modal) Open() {
+	if !m.visible {
+		m.visible = true
+		m.changed = true
+		m.focus = true
+	}
+}
+
+// Close hides the modal.
+func (m *Modal) Close() {
+	if m.visible {
+		m.visible = false
+		m.changed = true
+	}
+}
+
+// Visible reports whether the modal is open.
+func (m *Modal) Visible() bool {
+	return m.visible
+}
+
+// ChangeTime returns the time of the most recent opening or closing
+// of the modal. It is useful for animating the transition. Call
+// Update first to account for changes since the last frame.
+func (m *Modal) ChangeTime() time.Time {
+	return m.changeTime
+}
+
+// Update processes the dismissing input and records the time of
+// changes by Open and Close. Layout calls Update, but drawing that
+// depends on Visible or ChangeTime before Layout must call it first.
+func (m *Modal) Update(gtx layout.Context) {
+	if m.changed {
+		m.changed = false
+		m.changeTime = gtx.Now
+	}
+	for _, e := range m.scrim.Events(gtx) {
+		if e.Type == gesture.TypeClick && m.Dismissible {
+			m.close(gtx.Now)
+		}
+	}
+	for _, e := range gtx.Events(m) {
+		if e, ok := e.(key.Event); ok && e.Name == key.NameEscape && e.State == key.Press && m.Dismissible {
+			m.close(gtx.Now)
+		}
+	}
+}
+
+// Layout lays out w centered in the minimum constraints. While open,
+// the modal intercepts pointer input over the whole area and takes
+// the keyboard focus.
+func (m *Modal) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
+	m.Update(gtx)
+	size := gtx.Constraints.Min
+	if !m.visible {
+		return layout.Center.Layout(gtx, w)
+	}
+	stack := op.Save(gtx.Ops)
+	pointer.Rect(image.Rectangle{Max: size}).Add(gtx.Ops)
+	// The scrim handler hides the area beneath from pointer input.
+	m.scrim.Add(gtx.Ops)
+	key.InputOp{Tag: m}.Add(gtx.Ops)
+	if m.focus {
+		key.FocusOp{Tag: m}.Add(gtx.Ops)
+		m.focus = false
+	}
+	stack.Load()
+	return layout.Center.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+		macro := op.Record(gtx.Ops)
+		dims := w(gtx)
+		call := macro.Stop()
+		stack := op.Save(gtx.Ops)
+		// Block presses on the content from reaching the scrim.
+		pointer.Rect(image.Rectangle{Max: dims.Size}).Add(gtx.Ops)
+		pointer.InputOp{Tag: &m.content, Types: pointer.Press}.Add(gtx.Ops)
+		stack.Load()
+		call.Add(gtx.Ops)
+		return dims
+	})
+}
+
+func (m *Modal) close(now time.Time) {
+	if m.visible {
+		m.visible = false
+		m.changeTime = now
+	}
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestModalDismiss(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		m   = Modal{Dismissible: true}
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	frame := func() {
+		ops.Reset()
+		m.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layout.Dimensions{Size: image.Pt(20, 20)}
+		})
+		r.Frame(&ops)
+	}
+	click := func(x, y float32) {
+		r.Queue(
+			pointer.Event{
+				Source:   pointer.Mouse,
+				Buttons:  pointer.ButtonPrimary,
+				Type:     pointer.Press,
+				Position: f32.Pt(x, y),
+			},
+			pointer.Event{
+				Source:   pointer.Mouse,
+				Type:     pointer.Release,
+				Position: f32.Pt(x, y),
+			},
+		)
+		frame()
+	}
+	m.Open()
+	frame()
+	click(50, 50)
+	if !m.Visible() {
+		t.Error("modal closed by a click on its content")
+	}
+	click(5, 5)
+	if m.Visible() {
+		t.Error("modal open after a click on the scrim")
+	}
+	m.Open()
+	frame()
+	r.Queue(key.Event{Name: key.NameEscape, State: key.Press})
+	frame()
+	if m.Visible() {
+		t.Error("modal open after Escape")
+	}
+	m.Dismissible = false
+	m.Open()
+	frame()
+	click(5, 5)
+	if !m.Visible() {
+		t.Error("non-dismissible modal closed by a click on the scrim")
+	}
+}
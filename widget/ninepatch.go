@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+)
+
+// NinePatch is a widget that stretches an image to fill an area while
+// keeping its border fixed in size. The corners are painted unscaled,
+// the edges are stretched along their length and the center is
+// stretched in both directions.
+type NinePatch struct {
+	// Src is the image to display.
+	Src paint.ImageOp
+	// Top, Bottom, Left and Right are the sizes of the fixed
+	// border, in image pixels.
+	Top, Bottom, Left, Right int
+	// Scale is the ratio of image pixels to dps. If Scale is
+	// zero NinePatch falls back to a scale that match a standard
+	// 72 DPI.
+	Scale float32
+}
+
+// Layout the image stretched to the minimum constraints. If the area
+// is smaller than the border, the border is scaled down to fit.
+func (n NinePatch) Layout(gtx layout.Context) layout.Dimensions {
+	size := gtx.Constraints.Min
+	scale := n.Scale
+	if scale == 0 {
+		scale = defaultScale
+	}
+	pixelScale := scale * gtx.Metric.PxPerDp
+	isz := layout.FPt(n.Src.Size())
+	w, h := float32(size.X), float32(size.Y)
+	l, r := fitBorder(float32(n.Left)*pixelScale, float32(n.Right)*pixelScale, w)
+	t, b := fitBorder(float32(n.Top)*pixelScale, float32(n.Bottom)*pixelScale, h)
+	xs := [4]float32{0, l, w - r, w}
+	ys := [4]float32{0, t, h - b, h}
+	txs := [4]float32{0, float32(n.Left), isz.X - float32(n.Right), isz.X}
+	tys := [4]float32{0, float32(n.Top), isz.Y - float32(n.Bottom), isz.Y}
+
+	defer op.Save(gtx.Ops).Load()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			dst := f32.Rect(xs[i], ys[j], xs[i+1], ys[j+1])
+			src := f32.Rect(txs[i], tys[j], txs[i+1], tys[j+1])
+			paintStretched(gtx.Ops, n.Src, src, dst)
+		}
+	}
+	return layout.Dimensions{Size: size}
+}
+
+// fitBorder scales down the border sizes a and b in proportion if
+// they exceed length.
+func fitBorder(a, b, length float32) (float32, float32) {
+	if s := a + b; s > length && s > 0 {
+		f := length / s
+		a, b = a*f, b*f
+	}
+	return a, b
+}
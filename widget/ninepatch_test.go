@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+)
+
+func TestNinePatch(t *testing.T) {
+	var ops op.Ops
+	gtx := layout.Context{
+		Ops:         &ops,
+		Constraints: layout.Exact(image.Pt(60, 8)),
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, 12, 12))
+	n := NinePatch{Src: paint.NewImageOp(img), Top: 4, Bottom: 4, Left: 4, Right: 4, Scale: 1}
+	if got, want := n.Layout(gtx).Size, gtx.Constraints.Min; got != want {
+		t.Errorf("got size %v, want %v", got, want)
+	}
+}
+
+func TestFitBorder(t *testing.T) {
+	for _, tc := range []struct {
+		a, b, length float32
+		wa, wb       float32
+	}{
+		{a: 4, b: 4, length: 20, wa: 4, wb: 4},
+		{a: 4, b: 4, length: 4, wa: 2, wb: 2},
+		{a: 6, b: 2, length: 4, wa: 3, wb: 1},
+		{a: 0, b: 0, length: 0, wa: 0, wb: 0},
+	} {
+		a, b := fitBorder(tc.a, tc.b, tc.length)
+		if a != tc.wa || b != tc.wb {
+			t.Errorf("fitBorder(%v, %v, %v) = %v, %v; want %v, %v", tc.a, tc.b, tc.length, a, b, tc.wa, tc.wb)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/unit"
+)
+
+// Refresh holds the state of a pull-to-refresh gesture on a list:
+// dragging the list down past its start and releasing beyond a
+// threshold requests a refresh.
+type Refresh struct {
+	// Threshold is the distance the list must be dragged past its
+	// start to request a refresh. If zero, a default of 64dp is
+	// used.
+	Threshold unit.Value
+
+	pull       float32
+	armed      bool
+	refreshing bool
+	requested  bool
+	// changed is set when refreshing is started or ended outside
+	// of Layout.
+	changed    bool
+	changeTime time.Time
+}
+
+var defaultRefreshThreshold = unit.Dp(64)
+
+// Requested reports whether the user requested a refresh since the
+// last call to Requested. The refresh indicator is shown until End
+// is called.
+func (r *Refresh) Requested() bool {
+	req := r.requested
+	r.requested = false
+	return req
+}
+
+// Begin shows the refresh indicator, for refreshes not started by
+// the user.
+func (r *Refresh) Begin() {
+	if !r.refreshing {
+		r.refreshing = true
+		r.changed = true
+	}
+}
+
+// End hides the refresh indicator when the refresh completes.
+func (r *Refresh) End() {
+	if r.refreshing {
+		r.refreshing = false
+		r.changed = true
+	}
+}
+
+// Refreshing reports whether a refresh is in progress.
+func (r *Refresh) Refreshing() bool {
+	return r.refreshing
+}
+
+// Pull returns the distance the list is dragged past its start, as
+// a fraction of the threshold.
+func (r *Refresh) Pull() float32 {
+	return r.pull
+}
+
+// Armed reports whether releasing the list requests a refresh.
+func (r *Refresh) Armed() bool {
+	return r.armed
+}
+
+// ChangeTime returns the time the most recent refresh started or
+// ended. It is useful for animating the indicator.
+func (r *Refresh) ChangeTime() time.Time {
+	return r.changeTime
+}
+
+// Layout lays out the list l with w and updates the refresh state
+// from its overscroll.
+func (r *Refresh) Layout(gtx layout.Context, l *layout.List, w layout.Widget) layout.Dimensions {
+	dims := w(gtx)
+	if r.changed {
+		r.changed = false
+		r.changeTime = gtx.Now
+	}
+	th := r.Threshold
+	if th.V == 0 {
+		th = defaultRefreshThreshold
+	}
+	r.pull = 0
+	if o := l.Overscroll(); o < 0 {
+		r.pull = -o / float32(gtx.Px(th))
+	}
+	switch {
+	case l.Dragging():
+		r.armed = !r.refreshing && r.pull >= 1
+	case r.armed:
+		r.armed = false
+		r.refreshing = true
+		r.requested = true
+		r.changeTime = gtx.Now
+	}
+	return dims
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+func TestRefresh(t *testing.T) {
+	var (
+		ops  op.Ops
+		r    router.Router
+		list = layout.List{Axis: layout.Vertical}
+		ref  = Refresh{Threshold: unit.Px(50)}
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Now:         time.Unix(0, 0),
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	w := func(gtx layout.Context) layout.Dimensions {
+		return list.Layout(gtx, 10, func(gtx layout.Context, i int) layout.Dimensions {
+			return layout.Dimensions{Size: image.Pt(100, 20)}
+		})
+	}
+	touch := func(typ pointer.Type, y float32) pointer.Event {
+		return pointer.Event{
+			Type:     typ,
+			Source:   pointer.Touch,
+			Position: f32.Pt(50, y),
+		}
+	}
+	frame := func(e ...event.Event) {
+		r.Frame(&ops)
+		r.Queue(e...)
+		ops.Reset()
+		ref.Layout(gtx, &list, w)
+	}
+	frame()
+	frame(touch(pointer.Press, 10), touch(pointer.Drag, 20))
+	frame(touch(pointer.Drag, 40))
+	if ref.Armed() {
+		t.Fatalf("refresh armed at pull %v", ref.Pull())
+	}
+	frame(touch(pointer.Drag, 80))
+	if !ref.Armed() {
+		t.Fatalf("refresh not armed at pull %v", ref.Pull())
+	}
+	frame(touch(pointer.Release, 80))
+	if !ref.Requested() || !ref.Refreshing() {
+		t.Fatal("releasing an armed refresh didn't request a refresh")
+	}
+	if ref.Requested() {
+		t.Error("refresh requested twice")
+	}
+	ref.End()
+	frame()
+	if ref.Refreshing() {
+		t.Error("refreshing after End")
+	}
+}
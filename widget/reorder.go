@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"math"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/gesture"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Reorder holds the state of a list whose items can be reordered by
+// long pressing and dragging them. While an item is dragged, the
+// other items move aside to make room for it.
+type Reorder struct {
+	items []reorderItem
+
+	lifted bool
+	// from is the index of the lifted item, and to the index it
+	// would be dropped at.
+	from, to int
+	// pos is the displacement of the lifted item, in pixels.
+	pos float32
+
+	perm    []int
+	dropped bool
+
+	moving   bool
+	animTime time.Time
+}
+
+type reorderItem struct {
+	click Clickable
+	drag  gesture.Drag
+	// start is the position of the press along the list axis.
+	start float32
+	// size is the size of the item along the list axis.
+	size int
+	// shift is the displacement of the item making room for the
+	// lifted item.
+	shift float32
+}
+
+// liftQueue withholds drag events until the item is lifted, to
+// avoid grabbing the pointer from the enclosing list before a long
+// press.
+type liftQueue struct {
+	q      event.Queue
+	lifted bool
+}
+
+// reorderDecay is the rate of exponential decay of the distance of
+// items to their resting positions, per second.
+const reorderDecay = 20
+
+// Lifted reports whether item i is the dragged item.
+func (r *Reorder) Lifted(i int) bool {
+	return r.lifted && r.from == i
+}
+
+// Dropped returns the permutation of the items by the most recent
+// drop, if any, since the last call to Dropped. The item now at
+// index i was at index perm[i]. The items must be reordered
+// accordingly before the next call to Layout.
+func (r *Reorder) Dropped() (perm []int, ok bool) {
+	if !r.dropped {
+		return nil, false
+	}
+	r.dropped = false
+	return r.perm, true
+}
+
+// Layout lays out n items with w in l. The lifted item is drawn on
+// top of the others.
+func (r *Reorder) Layout(gtx layout.Context, l *layout.List, n int, w layout.ListElement) layout.Dimensions {
+	if len(r.items) != n {
+		r.items = make([]reorderItem, n)
+		r.lifted = false
+	}
+	r.update(gtx, l.Axis)
+	return l.Layout(gtx, n, func(gtx layout.Context, i int) layout.Dimensions {
+		return r.layoutItem(gtx, l.Axis, i, w)
+	})
+}
+
+func (r *Reorder) layoutItem(gtx layout.Context, axis layout.Axis, i int, w layout.ListElement) layout.Dimensions {
+	it := &r.items[i]
+	macro := op.Record(gtx.Ops)
+	dims := w(gtx, i)
+	stack := op.Save(gtx.Ops)
+	// The handlers are on top of the content; let events pass
+	// through to input handlers of the content.
+	pointer.PassOp{Pass: true}.Add(gtx.Ops)
+	pointer.Rect(image.Rectangle{Max: dims.Size}).Add(gtx.Ops)
+	it.drag.Add(gtx.Ops)
+	if r.Lifted(i) {
+		// Grab the pointer from the enclosing list as soon as the
+		// item is lifted.
+		pointer.InputOp{Tag: &it.drag, Grab: true, Types: pointer.Drag | pointer.Release}.Add(gtx.Ops)
+	}
+	gtx.Constraints.Min = dims.Size
+	it.click.Layout(gtx)
+	stack.Load()
+	call := macro.Stop()
+	it.size = axis.Convert(dims.Size).X
+
+	defer op.Save(gtx.Ops).Load()
+	off := it.shift
+	if r.Lifted(i) {
+		off = r.pos
+	}
+	off = float32(math.Round(float64(off)))
+	if axis == layout.Horizontal {
+		op.Offset(f32.Pt(off, 0)).Add(gtx.Ops)
+	} else {
+		op.Offset(f32.Pt(0, off)).Add(gtx.Ops)
+	}
+	if r.Lifted(i) {
+		op.Defer(gtx.Ops, call)
+	} else {
+		call.Add(gtx.Ops)
+	}
+	return dims
+}
+
+func (r *Reorder) update(gtx layout.Context, axis layout.Axis) {
+	if !r.lifted {
+		for i := range r.items {
+			if r.items[i].click.LongPressed() {
+				r.lifted = true
+				r.from, r.to = i, i
+				r.pos = 0
+				break
+			}
+		}
+	}
+	for i := range r.items {
+		it := &r.items[i]
+		lifted := r.Lifted(i)
+		// The item moves along with the pointer, so positions are
+		// relative to the displacement of the previous frame.
+		pos := float32(math.Round(float64(r.pos)))
+		for _, e := range it.drag.Events(gtx.Metric, liftQueue{q: gtx, lifted: lifted}, gesture.Axis(axis)) {
+			p := e.Position.X
+			if axis == layout.Vertical {
+				p = e.Position.Y
+			}
+			switch e.Type {
+			case pointer.Press:
+				it.start = p
+			case pointer.Drag:
+				if lifted {
+					r.pos = pos + p - it.start
+				}
+			case pointer.Release:
+				if lifted {
+					r.drop()
+				}
+			case pointer.Cancel:
+				if lifted {
+					it.shift = r.pos
+					r.lifted = false
+				}
+			}
+		}
+	}
+	if r.lifted {
+		r.to = r.target()
+	}
+	r.animate(gtx)
+}
+
+// target returns the index the lifted item would be dropped at.
+func (r *Reorder) target() int {
+	to := r.from
+	if r.pos > 0 {
+		var d float32
+		for j := r.from + 1; j < len(r.items); j++ {
+			s := float32(r.items[j].size)
+			if r.pos < d+s*.5 {
+				break
+			}
+			d += s
+			to = j
+		}
+	} else {
+		var d float32
+		for j := r.from - 1; j >= 0; j-- {
+			s := float32(r.items[j].size)
+			if -r.pos < d+s*.5 {
+				break
+			}
+			d += s
+			to = j
+		}
+	}
+	return to
+}
+
+// rest returns the resting displacement of item j while the lifted
+// item is over index r.to.
+func (r *Reorder) rest(j int) float32 {
+	if !r.lifted {
+		return 0
+	}
+	s := float32(r.items[r.from].size)
+	switch {
+	case r.from < j && j <= r.to:
+		return -s
+	case r.to <= j && j < r.from:
+		return s
+	}
+	return 0
+}
+
+// drop the lifted item at r.to and reorder the items.
+func (r *Reorder) drop() {
+	from, to := r.from, r.to
+	perm := make([]int, len(r.items))
+	for i := range perm {
+		perm[i] = i
+	}
+	// slot is the distance from the lifted item's old position to
+	// its new position.
+	var slot float32
+	if to > from {
+		copy(perm[from:], perm[from+1:to+1])
+		for j := from + 1; j <= to; j++ {
+			slot += float32(r.items[j].size)
+		}
+	} else {
+		copy(perm[to+1:], perm[to:from])
+		for j := to; j < from; j++ {
+			slot -= float32(r.items[j].size)
+		}
+	}
+	perm[to] = from
+	items := make([]reorderItem, len(r.items))
+	for i, old := range perm {
+		it := r.items[old]
+		// Keep the items at their displayed positions and let
+		// them animate to the new ones.
+		it.shift -= r.rest(old)
+		if old == from {
+			it.shift = r.pos - slot
+		}
+		items[i] = it
+	}
+	r.items = items
+	r.lifted = false
+	r.perm = perm
+	r.dropped = true
+}
+
+// animate the items towards their resting positions.
+func (r *Reorder) animate(gtx layout.Context) {
+	moving := false
+	for j := range r.items {
+		if r.Lifted(j) {
+			continue
+		}
+		if r.items[j].shift != r.rest(j) {
+			moving = true
+			break
+		}
+	}
+	if !moving {
+		r.moving = false
+		return
+	}
+	if !r.moving {
+		r.moving = true
+		r.animTime = gtx.Now
+	}
+	dt := gtx.Now.Sub(r.animTime).Seconds()
+	r.animTime = gtx.Now
+	decay := float32(math.Exp(-reorderDecay * dt))
+	for j := range r.items {
+		it := &r.items[j]
+		rest := r.rest(j)
+		it.shift = rest + (it.shift-rest)*decay
+		if d := it.shift - rest; -.5 < d && d < .5 {
+			it.shift = rest
+		}
+	}
+	op.InvalidateOp{}.Add(gtx.Ops)
+}
+
+func (q liftQueue) Events(t event.Tag) []event.Event {
+	events := q.q.Events(t)
+	if q.lifted {
+		return events
+	}
+	var filtered []event.Event
+	for _, e := range events {
+		if e, ok := e.(pointer.Event); ok && e.Type == pointer.Drag {
+			continue
+		}
+		filtered = append(filtered, e)
+	}
+	return filtered
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"reflect"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestReorder(t *testing.T) {
+	var (
+		ops  op.Ops
+		r    router.Router
+		list = layout.List{Axis: layout.Vertical}
+		ro   Reorder
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Now:         time.Unix(0, 0),
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	el := func(gtx layout.Context, i int) layout.Dimensions {
+		return layout.Dimensions{Size: image.Pt(100, 20)}
+	}
+	touch := func(typ pointer.Type, y float32) pointer.Event {
+		return pointer.Event{
+			Type:     typ,
+			Source:   pointer.Touch,
+			Position: f32.Pt(50, y),
+		}
+	}
+	frame := func(e ...event.Event) {
+		r.Frame(&ops)
+		r.Queue(e...)
+		ops.Reset()
+		ro.Layout(gtx, &list, 5, el)
+	}
+	frame()
+	frame(touch(pointer.Press, 10))
+	// Drags before the long press are left to the list.
+	frame(touch(pointer.Drag, 11))
+	if ro.Lifted(0) {
+		t.Fatal("item lifted before the long press")
+	}
+	gtx.Now = gtx.Now.Add(defaultLongPressDuration)
+	frame()
+	frame()
+	if !ro.Lifted(0) {
+		t.Fatal("item not lifted after the long press")
+	}
+	frame(touch(pointer.Drag, 55))
+	if ro.to != 2 {
+		t.Errorf("got drop target %d, expected 2", ro.to)
+	}
+	if s := ro.items[1].shift; s != 0 {
+		t.Errorf("got shift %v before the animation", s)
+	}
+	gtx.Now = gtx.Now.Add(time.Second)
+	frame()
+	if s := ro.items[1].shift; s != -20 {
+		t.Errorf("got shift %v after the animation, expected -20", s)
+	}
+	frame(touch(pointer.Release, 55))
+	perm, ok := ro.Dropped()
+	if !ok {
+		t.Fatal("no drop reported")
+	}
+	if want := []int{1, 2, 0, 3, 4}; !reflect.DeepEqual(perm, want) {
+		t.Errorf("got permutation %v, expected %v", perm, want)
+	}
+	gtx.Now = gtx.Now.Add(time.Second)
+	frame()
+	frame()
+	for i, it := range ro.items {
+		if it.shift != 0 {
+			t.Errorf("item %d: got shift %v after the drop", i, it.shift)
+		}
+	}
+}
+
+func TestReorderContentClick(t *testing.T) {
+	var (
+		ops    op.Ops
+		r      router.Router
+		list   = layout.List{Axis: layout.Vertical}
+		ro     Reorder
+		button Clickable
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	el := func(gtx layout.Context, i int) layout.Dimensions {
+		gtx.Constraints = layout.Exact(image.Pt(100, 20))
+		if i == 1 {
+			return button.Layout(gtx)
+		}
+		return layout.Dimensions{Size: gtx.Constraints.Min}
+	}
+	frame := func(e ...event.Event) {
+		r.Frame(&ops)
+		r.Queue(e...)
+		ops.Reset()
+		ro.Layout(gtx, &list, 3, el)
+	}
+	frame()
+	press := pointer.Event{
+		Type:     pointer.Press,
+		Source:   pointer.Mouse,
+		Buttons:  pointer.ButtonPrimary,
+		Position: f32.Pt(50, 30),
+	}
+	release := press
+	release.Type = pointer.Release
+	frame(press, release)
+	if !button.Clicked() {
+		t.Error("button inside a reorderable item wasn't clicked")
+	}
+}
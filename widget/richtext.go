@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"image/color"
+	"unicode"
+	"unicode/utf8"
+
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/paint"
+	"gioui.org/text"
+	"gioui.org/unit"
+
+	"golang.org/x/image/math/fixed"
+)
+
+// SpanStyle describes a run of text with its own style.
+type SpanStyle struct {
+	Font    text.Font
+	Size    unit.Value
+	Color   color.NRGBA
+	Content string
+	// Clickable, if non-nil, makes the span respond to clicks on
+	// its text.
+	Clickable *Clickable
+	// HoverColor, if non-zero, replaces Color while the pointer
+	// hovers a clickable span.
+	HoverColor color.NRGBA
+}
+
+// RichText is a widget for laying out and drawing spans of styled text
+// as a single paragraph.
+type RichText struct {
+	// Alignment specify the text alignment.
+	Alignment text.Alignment
+}
+
+// spanRun is a laid out piece of a span on a line.
+type spanRun struct {
+	span   int
+	size   fixed.Int26_6
+	layout text.Layout
+	// x is the offset of the run from the start of its line.
+	x fixed.Int26_6
+	// width is the advance of the run.
+	width fixed.Int26_6
+}
+
+// richLine is a line of runs.
+type richLine struct {
+	text.Line
+	runs []spanRun
+	// end is the width of the line including trailing space.
+	end fixed.Int26_6
+}
+
+func (r RichText) Layout(gtx layout.Context, s text.Shaper, spans ...SpanStyle) layout.Dimensions {
+	lines := layoutSpans(gtx, s, spans)
+	tlines := make([]text.Line, len(lines))
+	for i, l := range lines {
+		tlines[i] = l.Line
+	}
+	dims := linesDimens(tlines)
+	dims.Size = gtx.Constraints.Constrain(dims.Size)
+	// clickables tracks the clickable spans that processed their
+	// events.
+	clickables := make(map[*Clickable]bool)
+	var y, prevDesc fixed.Int26_6
+	for _, l := range lines {
+		y += prevDesc + l.Ascent
+		prevDesc = l.Descent
+		// Align baselines to the pixel grid.
+		y = fixed.I(y.Ceil())
+		x := align(r.Alignment, l.Width, dims.Size.X)
+		for _, run := range l.runs {
+			sp := spans[run.span]
+			stack := op.Save(gtx.Ops)
+			op.Offset(layout.FPt(image.Point{X: (x + run.x).Floor(), Y: y.Floor()})).Add(gtx.Ops)
+			col := sp.Color
+			if c := sp.Clickable; c != nil {
+				area := image.Rectangle{
+					Min: image.Point{Y: -l.Ascent.Ceil()},
+					Max: image.Point{X: run.width.Ceil(), Y: l.Descent.Ceil()},
+				}
+				layoutSpanArea(gtx, c, area, !clickables[c])
+				clickables[c] = true
+				if c.Hovered() && sp.HoverColor != (color.NRGBA{}) {
+					col = sp.HoverColor
+				}
+			}
+			paint.ColorOp{Color: col}.Add(gtx.Ops)
+			s.Shape(sp.Font, run.size, run.layout).Add(gtx.Ops)
+			paint.PaintOp{}.Add(gtx.Ops)
+			stack.Load()
+		}
+	}
+	return dims
+}
+
+// layoutSpanArea adds the input area of a clickable run, relative to
+// its baseline. Only the first area of a span updates c, because a
+// clickable span may be broken across lines.
+func layoutSpanArea(gtx layout.Context, c *Clickable, area image.Rectangle, first bool) {
+	defer op.Save(gtx.Ops).Load()
+	op.Offset(layout.FPt(area.Min)).Add(gtx.Ops)
+	size := area.Size()
+	pointer.Rect(image.Rectangle{Max: size}).Add(gtx.Ops)
+	pointer.CursorNameOp{Name: pointer.CursorPointer}.Add(gtx.Ops)
+	if first {
+		gtx.Constraints = layout.Exact(size)
+		c.Layout(gtx)
+		return
+	}
+	c.click.Add(gtx.Ops)
+}
+
+// layoutSpans breaks spans into lines that fit the maximum
+// constraints. Lines break between words, or inside words that
+// don't fit on a line by themselves.
+func layoutSpans(gtx layout.Context, s text.Shaper, spans []SpanStyle) []richLine {
+	maxWidth := fixed.I(gtx.Constraints.Max.X)
+	var lines []richLine
+	var cur richLine
+	newLine := func() {
+		lines = append(lines, cur)
+		cur = richLine{}
+	}
+	for i, sp := range spans {
+		size := fixed.I(gtx.Px(sp.Size))
+		for _, word := range splitWords(sp.Content) {
+			ls := s.LayoutString(sp.Font, size, inf, word)
+			if len(ls) == 0 {
+				continue
+			}
+			if word == "\n" {
+				cur.fitMetrics(ls[0])
+				newLine()
+				continue
+			}
+			lay := ls[0].Layout
+			for len(lay.Advances) > 0 {
+				n, w, visible := fitRunes(lay, maxWidth-cur.end)
+				if n < len(lay.Advances) && len(cur.runs) > 0 {
+					newLine()
+					continue
+				}
+				if n == 0 {
+					// Break inside a word too long for a line.
+					n, w, visible = 1, lay.Advances[0], lay.Advances[0]
+				}
+				nb := runeOffset(lay.Text, n)
+				cur.runs = append(cur.runs, spanRun{
+					span:   i,
+					size:   size,
+					layout: text.Layout{Text: lay.Text[:nb], Advances: lay.Advances[:n]},
+					x:      cur.end,
+					width:  w,
+				})
+				cur.fitMetrics(ls[0])
+				if visible > 0 {
+					cur.Width = cur.end + visible
+					cur.Bounds.Max.X = cur.Width
+				}
+				cur.end += w
+				lay.Text, lay.Advances = lay.Text[nb:], lay.Advances[n:]
+				if len(lay.Advances) > 0 {
+					newLine()
+				}
+			}
+		}
+	}
+	if len(cur.runs) > 0 || len(lines) == 0 {
+		lines = append(lines, cur)
+	}
+	return lines
+}
+
+// fitMetrics grows the line height to fit l.
+func (r *richLine) fitMetrics(l text.Line) {
+	if l.Ascent > r.Ascent {
+		r.Ascent = l.Ascent
+	}
+	if l.Descent > r.Descent {
+		r.Descent = l.Descent
+	}
+	if l.Bounds.Min.Y < r.Bounds.Min.Y {
+		r.Bounds.Min.Y = l.Bounds.Min.Y
+	}
+	if l.Bounds.Max.Y > r.Bounds.Max.Y {
+		r.Bounds.Max.Y = l.Bounds.Max.Y
+	}
+}
+
+// fitRunes returns the number of runes of l that fit in width, not
+// counting trailing space. It also returns their total width and the
+// width excluding trailing space.
+func fitRunes(l text.Layout, width fixed.Int26_6) (n int, w, visible fixed.Int26_6) {
+	i := 0
+	for _, r := range l.Text {
+		adv := l.Advances[i]
+		i++
+		if unicode.IsSpace(r) {
+			w += adv
+			n = i
+			continue
+		}
+		if w+adv > width {
+			break
+		}
+		w += adv
+		visible = w
+		n = i
+	}
+	return n, w, visible
+}
+
+// runeOffset returns the byte offset of rune n in s.
+func runeOffset(s string, n int) int {
+	off := 0
+	for ; n > 0; n-- {
+		_, size := utf8.DecodeRuneInString(s[off:])
+		off += size
+	}
+	return off
+}
+
+// splitWords splits s into words that include their trailing space.
+// Line breaks are separate words.
+func splitWords(s string) []string {
+	var words []string
+	start := 0
+	space := false
+	for i, r := range s {
+		switch {
+		case r == '\n':
+			if start < i {
+				words = append(words, s[start:i])
+			}
+			words = append(words, "\n")
+			start = i + 1
+			space = false
+		case unicode.IsSpace(r):
+			space = true
+		case space:
+			words = append(words, s[start:i])
+			start = i
+			space = false
+		}
+	}
+	if start < len(s) {
+		words = append(words, s[start:])
+	}
+	return words
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"reflect"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/font/gofont"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/text"
+	"gioui.org/unit"
+
+	"golang.org/x/image/math/fixed"
+)
+
+func TestSplitWords(t *testing.T) {
+	got := splitWords("ab  cd\nef ")
+	want := []string{"ab  ", "cd", "\n", "ef "}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRichTextWrap(t *testing.T) {
+	gtx := layout.Context{
+		Ops: new(op.Ops),
+		Constraints: layout.Constraints{
+			Max: image.Pt(60, 1000),
+		},
+	}
+	cache := text.NewCache(gofont.Collection())
+	small := SpanStyle{Size: unit.Px(10), Content: "one two "}
+	bold := SpanStyle{Size: unit.Px(10), Font: text.Font{Weight: text.Bold}, Content: "three four five"}
+	lines := layoutSpans(gtx, cache, []SpanStyle{small, bold})
+	if len(lines) < 2 {
+		t.Fatalf("got %d lines, expected the spans to wrap", len(lines))
+	}
+	spans := map[int]bool{}
+	for i, l := range lines {
+		if l.Width > fixed.I(gtx.Constraints.Max.X) {
+			t.Errorf("line %d is %v wide, exceeds %d", i, l.Width, gtx.Constraints.Max.X)
+		}
+		for _, r := range l.runs {
+			spans[r.span] = true
+		}
+	}
+	if !spans[0] || !spans[1] {
+		t.Errorf("got runs from spans %v, want both", spans)
+	}
+	// A larger span makes its line taller.
+	large := SpanStyle{Size: unit.Px(20), Content: "big"}
+	one := RichText{}.Layout(gtx, cache, SpanStyle{Size: unit.Px(10), Content: "a"})
+	mixed := RichText{}.Layout(gtx, cache, SpanStyle{Size: unit.Px(10), Content: "a "}, large)
+	if mixed.Size.Y <= one.Size.Y {
+		t.Errorf("got height %d for mixed sizes, want more than %d", mixed.Size.Y, one.Size.Y)
+	}
+	// Words longer than a line are broken.
+	long := SpanStyle{Size: unit.Px(10), Content: "abcdefghijklmnopqrstuvwxyz"}
+	if n := len(layoutSpans(gtx, cache, []SpanStyle{long})); n < 2 {
+		t.Errorf("got %d lines for a long word, expected it to break", n)
+	}
+}
+
+func TestRichTextClick(t *testing.T) {
+	var (
+		r    router.Router
+		link Clickable
+	)
+	gtx := layout.Context{
+		Ops:   new(op.Ops),
+		Queue: &r,
+		Constraints: layout.Constraints{
+			Max: image.Pt(40, 1000),
+		},
+	}
+	cache := text.NewCache(gofont.Collection())
+	spans := []SpanStyle{{Size: unit.Px(10), Content: "see the terms of use", Clickable: &link}}
+	dims := RichText{}.Layout(gtx, cache, spans...)
+	r.Frame(gtx.Ops)
+	// Click the start of the last line of the wrapped link.
+	pos := f32.Pt(2, float32(dims.Size.Y)-4)
+	r.Queue(
+		pointer.Event{Type: pointer.Press, Source: pointer.Mouse, Buttons: pointer.ButtonPrimary, Position: pos},
+		pointer.Event{Type: pointer.Release, Source: pointer.Mouse, Position: pos},
+	)
+	gtx.Ops.Reset()
+	RichText{}.Layout(gtx, cache, spans...)
+	if !link.Clicked() {
+		t.Error("link not clicked")
+	}
+}
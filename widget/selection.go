@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"gioui.org/layout"
+)
+
+// Selection is a set of selected keys, where clicking a key toggles
+// its selection. It complements Enum for choices of several keys.
+type Selection struct {
+	// Selected contains the selected keys. A nil Selected is
+	// allocated when a key is first toggled.
+	Selected map[string]bool
+	hovered  string
+	hovering bool
+
+	changed bool
+
+	clicks []*Clickable
+	values []string
+}
+
+// Changed reports whether Selected has changed by user interaction
+// since the last call to Changed.
+func (s *Selection) Changed() bool {
+	changed := s.changed
+	s.changed = false
+	return changed
+}
+
+// Hovered returns the key that is highlighted, or false if none are.
+func (s *Selection) Hovered() (string, bool) {
+	return s.hovered, s.hovering
+}
+
+// Focused returns the key that has the keyboard focus, or false if
+// none do.
+func (s *Selection) Focused() (string, bool) {
+	for i, c := range s.clicks {
+		if c.Focused() {
+			return s.values[i], true
+		}
+	}
+	return "", false
+}
+
+// History is the past pointer presses of key useful for drawing
+// markers.
+func (s *Selection) History(key string) []Press {
+	if idx := index(s.values, key); idx != -1 {
+		return s.clicks[idx].History()
+	}
+	return nil
+}
+
+// Layout adds the event handler for key.
+func (s *Selection) Layout(gtx layout.Context, key string) layout.Dimensions {
+	idx := index(s.values, key)
+	if idx == -1 {
+		s.values = append(s.values, key)
+		s.clicks = append(s.clicks, &Clickable{Focusable: true})
+		idx = len(s.clicks) - 1
+	}
+	clk := s.clicks[idx]
+	dims := clk.Layout(gtx)
+	for clk.Clicked() {
+		if s.Selected == nil {
+			s.Selected = make(map[string]bool)
+		}
+		if s.Selected[key] {
+			delete(s.Selected, key)
+		} else {
+			s.Selected[key] = true
+		}
+		s.changed = true
+	}
+	if s.hovering && s.hovered == key {
+		s.hovering = false
+	}
+	if clk.Hovered() {
+		s.hovered = key
+		s.hovering = true
+	}
+	return dims
+}
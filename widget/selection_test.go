@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"reflect"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestSelectionToggle(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		s   Selection
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(50, 50)),
+	}
+	keys := []string{"a", "b"}
+	frame := func() {
+		ops.Reset()
+		for i, k := range keys {
+			stack := op.Save(&ops)
+			op.Offset(f32.Pt(float32(i*50), 0)).Add(&ops)
+			s.Layout(gtx, k)
+			stack.Load()
+		}
+		r.Frame(&ops)
+	}
+	click := func(x float32) {
+		press := pointer.Event{
+			Type:     pointer.Press,
+			Source:   pointer.Mouse,
+			Buttons:  pointer.ButtonPrimary,
+			Position: f32.Pt(x, 25),
+		}
+		release := press
+		release.Type = pointer.Release
+		r.Queue(press, release)
+		frame()
+	}
+	frame()
+	for _, tc := range []struct {
+		x    float32
+		want map[string]bool
+	}{
+		{x: 25, want: map[string]bool{"a": true}},
+		{x: 75, want: map[string]bool{"a": true, "b": true}},
+		{x: 25, want: map[string]bool{"b": true}},
+	} {
+		click(tc.x)
+		if !s.Changed() {
+			t.Errorf("click at %v: no change reported", tc.x)
+		}
+		if !reflect.DeepEqual(s.Selected, tc.want) {
+			t.Errorf("click at %v: got selection %v, expected %v", tc.x, s.Selected, tc.want)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// SnackbarMessage is a message shown by a Snackbar.
+type SnackbarMessage struct {
+	Text string
+	// Action is the label of an optional action button.
+	Action string
+}
+
+// Snackbar holds the state of a queue of transient messages, shown
+// one at a time.
+type Snackbar struct {
+	// Timeout is the duration a message is shown, excluding the
+	// time the pointer is over it. If zero, a default of 4s is used.
+	Timeout time.Duration
+	// Action is the state of the action button of the current
+	// message.
+	Action Clickable
+
+	queue    []SnackbarMessage
+	age      time.Duration
+	last     time.Time
+	hovered  bool
+	actioned []SnackbarMessage
+}
+
+const defaultSnackbarTimeout = 4 * time.Second
+
+// Show adds msg to the queue of messages.
+func (s *Snackbar) Show(msg SnackbarMessage) {
+	s.queue = append(s.queue, msg)
+}
+
+// Current returns the message being shown, if any.
+func (s *Snackbar) Current() (SnackbarMessage, bool) {
+	if len(s.queue) == 0 {
+		return SnackbarMessage{}, false
+	}
+	return s.queue[0], true
+}
+
+// Age returns the duration the current message has been shown,
+// excluding the time the pointer was over it.
+func (s *Snackbar) Age() time.Duration {
+	return s.age
+}
+
+// TimeoutDuration returns the duration each message is shown.
+func (s *Snackbar) TimeoutDuration() time.Duration {
+	if s.Timeout > 0 {
+		return s.Timeout
+	}
+	return defaultSnackbarTimeout
+}
+
+// Actioned returns the messages whose action was clicked since the
+// last call to Actioned.
+func (s *Snackbar) Actioned() []SnackbarMessage {
+	a := s.actioned
+	s.actioned = nil
+	return a
+}
+
+// Layout lays out the current message with w and advances its age.
+// The message is dismissed when it times out or when its action is
+// clicked. Pointer events are passed through to the handlers of w.
+func (s *Snackbar) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
+	s.update(gtx)
+	if len(s.queue) == 0 {
+		return layout.Dimensions{}
+	}
+	dims := w(gtx)
+	for s.Action.Clicked() {
+		if len(s.queue) > 0 {
+			s.actioned = append(s.actioned, s.queue[0])
+			s.next(gtx.Now)
+		}
+	}
+	defer op.Save(gtx.Ops).Load()
+	pointer.PassOp{Pass: true}.Add(gtx.Ops)
+	pointer.Rect(image.Rectangle{Max: dims.Size}).Add(gtx.Ops)
+	pointer.InputOp{Tag: s, Types: pointer.Enter | pointer.Leave}.Add(gtx.Ops)
+	if !s.hovered && len(s.queue) > 0 {
+		op.InvalidateOp{At: gtx.Now.Add(s.TimeoutDuration() - s.age)}.Add(gtx.Ops)
+	}
+	return dims
+}
+
+func (s *Snackbar) update(gtx layout.Context) {
+	for _, e := range gtx.Events(s) {
+		e, ok := e.(pointer.Event)
+		if !ok {
+			continue
+		}
+		switch e.Type {
+		case pointer.Enter:
+			s.hovered = true
+		case pointer.Leave, pointer.Cancel:
+			s.hovered = false
+		}
+	}
+	if len(s.queue) == 0 {
+		s.last = time.Time{}
+		return
+	}
+	if !s.last.IsZero() && !s.hovered {
+		s.age += gtx.Now.Sub(s.last)
+	}
+	s.last = gtx.Now
+	if s.age >= s.TimeoutDuration() {
+		s.next(gtx.Now)
+	}
+}
+
+// next dismisses the current message.
+func (s *Snackbar) next(now time.Time) {
+	s.queue = s.queue[:copy(s.queue, s.queue[1:])]
+	s.age = 0
+	s.last = now
+	s.hovered = false
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestSnackbarQueue(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		s   = Snackbar{Timeout: time.Second}
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Now:         time.Unix(0, 0),
+		Constraints: layout.Exact(image.Pt(100, 20)),
+	}
+	frame := func(d time.Duration) {
+		gtx.Now = gtx.Now.Add(d)
+		ops.Reset()
+		s.Layout(gtx, func(gtx layout.Context) layout.Dimensions {
+			return layout.Dimensions{Size: gtx.Constraints.Min}
+		})
+		r.Frame(&ops)
+	}
+	current := func() string {
+		msg, _ := s.Current()
+		return msg.Text
+	}
+	s.Show(SnackbarMessage{Text: "a"})
+	s.Show(SnackbarMessage{Text: "b"})
+	frame(0)
+	frame(500 * time.Millisecond)
+	if got := current(); got != "a" {
+		t.Fatalf("got message %q, expected a", got)
+	}
+	// Hovering pauses the timeout.
+	r.Queue(pointer.Event{Type: pointer.Move, Position: f32.Pt(50, 10)})
+	frame(0)
+	frame(time.Second)
+	if got := current(); got != "a" {
+		t.Fatalf("got message %q while hovered, expected a", got)
+	}
+	r.Queue(pointer.Event{Type: pointer.Move, Position: f32.Pt(50, 50)})
+	frame(0)
+	frame(500 * time.Millisecond)
+	if got := current(); got != "b" {
+		t.Fatalf("got message %q after timeout, expected b", got)
+	}
+	frame(time.Second)
+	if _, ok := s.Current(); ok {
+		t.Error("message shown after the queue timed out")
+	}
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+
+	"gioui.org/gesture"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/unit"
+)
+
+// Split holds the state of two panes separated by a divider that
+// can be dragged to reallocate the space between them.
+type Split struct {
+	// Axis is the axis along which the panes are laid out.
+	Axis layout.Axis
+	// Ratio is the position of the divider, from -1 at the start to
+	// 1 at the end. The zero value centers the divider.
+	Ratio float32
+	// MinFirst and MinSecond are the minimum sizes of the panes.
+	MinFirst, MinSecond unit.Value
+
+	drag gesture.Drag
+	// start is the position of the press within the divider.
+	start float32
+}
+
+// Dragging reports whether the divider is being dragged.
+func (s *Split) Dragging() bool {
+	return s.drag.Dragging()
+}
+
+// Layout lays out the first and second panes and the divider between
+// them over the maximum constraints. The divider is laid out with the
+// exact cross size of the panes, and its main size determines the
+// space between them.
+func (s *Split) Layout(gtx layout.Context, first, divider, second layout.Widget) layout.Dimensions {
+	size := gtx.Constraints.Max
+	max := s.Axis.Convert(size)
+	macro := op.Record(gtx.Ops)
+	dgtx := gtx
+	dgtx.Constraints = layout.Constraints{
+		Min: s.Axis.Convert(image.Pt(0, max.Y)),
+		Max: size,
+	}
+	ddims := divider(dgtx)
+	call := macro.Stop()
+	bar := s.Axis.Convert(ddims.Size).X
+	avail := max.X - bar
+	if avail < 0 {
+		avail = 0
+	}
+
+	pos := s.position(gtx, avail)
+	for _, e := range s.drag.Events(gtx.Metric, gtx, gesture.Axis(s.Axis)) {
+		p := e.Position.X
+		if s.Axis == layout.Vertical {
+			p = e.Position.Y
+		}
+		switch e.Type {
+		case pointer.Press:
+			s.start = p
+		case pointer.Drag:
+			// The divider moves with the pointer, so the position
+			// is relative to its position in the previous frame.
+			if avail > 0 {
+				at := float32(pos) + p - s.start
+				s.Ratio = at/float32(avail)*2 - 1
+			}
+		}
+	}
+	pos = s.position(gtx, avail)
+
+	s.layoutPane(gtx, 0, pos, max.Y, first)
+	stack := op.Save(gtx.Ops)
+	op.Offset(layout.FPt(s.Axis.Convert(image.Pt(pos, 0)))).Add(gtx.Ops)
+	area := image.Rectangle{Max: s.Axis.Convert(image.Pt(bar, max.Y))}
+	pointer.Rect(area).Add(gtx.Ops)
+	cursor := pointer.CursorColResize
+	if s.Axis == layout.Vertical {
+		cursor = pointer.CursorRowResize
+	}
+	pointer.CursorNameOp{Name: cursor}.Add(gtx.Ops)
+	s.drag.Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	stack.Load()
+	s.layoutPane(gtx, pos+bar, avail-pos, max.Y, second)
+	return layout.Dimensions{Size: size}
+}
+
+// position returns the size of the first pane from the ratio,
+// limited by the minimum sizes.
+func (s *Split) position(gtx layout.Context, avail int) int {
+	r := s.Ratio
+	if r < -1 {
+		r = -1
+	} else if r > 1 {
+		r = 1
+	}
+	pos := int((r+1)*.5*float32(avail) + .5)
+	if m := avail - gtx.Px(s.MinSecond); pos > m {
+		pos = m
+	}
+	if m := gtx.Px(s.MinFirst); pos < m {
+		pos = m
+	}
+	if pos > avail {
+		pos = avail
+	}
+	if pos < 0 {
+		pos = 0
+	}
+	return pos
+}
+
+func (s *Split) layoutPane(gtx layout.Context, off, size, cross int, w layout.Widget) {
+	defer op.Save(gtx.Ops).Load()
+	sz := s.Axis.Convert(image.Pt(size, cross))
+	op.Offset(layout.FPt(s.Axis.Convert(image.Pt(off, 0)))).Add(gtx.Ops)
+	clip.Rect{Max: sz}.Add(gtx.Ops)
+	gtx.Constraints = layout.Exact(sz)
+	w(gtx)
+}
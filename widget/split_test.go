@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+func TestSplit(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		s   = Split{MinSecond: unit.Px(30)}
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 50)),
+	}
+	var sizes [2]image.Point
+	pane := func(i int) layout.Widget {
+		return func(gtx layout.Context) layout.Dimensions {
+			sizes[i] = gtx.Constraints.Min
+			return layout.Dimensions{Size: gtx.Constraints.Min}
+		}
+	}
+	divider := func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: image.Pt(10, gtx.Constraints.Min.Y)}
+	}
+	mouse := func(typ pointer.Type, x float32) pointer.Event {
+		return pointer.Event{
+			Type:     typ,
+			Source:   pointer.Mouse,
+			Buttons:  pointer.ButtonPrimary,
+			Position: f32.Pt(x, 25),
+		}
+	}
+	frame := func(e ...event.Event) {
+		r.Frame(&ops)
+		r.Queue(e...)
+		ops.Reset()
+		s.Layout(gtx, pane(0), divider, pane(1))
+	}
+	frame()
+	if sizes[0] != image.Pt(45, 50) || sizes[1] != image.Pt(45, 50) {
+		t.Errorf("got pane sizes %v, expected 45x50 each", sizes)
+	}
+	frame(mouse(pointer.Move, 50))
+	if got := r.Cursor(); got != pointer.CursorColResize {
+		t.Errorf("got cursor %v over the divider", got)
+	}
+	frame(mouse(pointer.Press, 50), mouse(pointer.Drag, 60))
+	frame(mouse(pointer.Drag, 58))
+	if sizes[0].X != 53 || sizes[1].X != 37 {
+		t.Errorf("got pane sizes %v after drag, expected widths 53 and 37", sizes)
+	}
+	frame(mouse(pointer.Drag, 90), mouse(pointer.Release, 90))
+	if sizes[0].X != 60 || sizes[1].X != 30 {
+		t.Errorf("got pane sizes %v, expected the second pane at its minimum size", sizes)
+	}
+}
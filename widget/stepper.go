@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"gioui.org/io/key"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Stepper holds the state of a numeric input with buttons for
+// incrementing and decrementing its value.
+type Stepper struct {
+	Value float32
+	// Min and Max bound Value. Value is unbounded if Max is not
+	// greater than Min.
+	Min, Max float32
+	// Step is the amount Value changes by a button press. If zero,
+	// a step of 1 is used.
+	Step float32
+	// Editor is the text field of the value.
+	Editor Editor
+	// Increment and Decrement are the states of the buttons.
+	Increment, Decrement Clickable
+
+	inc, dec stepRepeat
+	// shown is the value shown in the editor.
+	shown   float32
+	valid   bool
+	focused bool
+	changed bool
+}
+
+// stepRepeat tracks the auto-repeat of a held button.
+type stepRepeat struct {
+	// active is set while a press of the button steps the value.
+	active bool
+	count  int
+	next   time.Time
+}
+
+const (
+	// stepRepeatDelay is the delay before a held button repeats.
+	stepRepeatDelay = 400 * time.Millisecond
+	// stepRepeatInterval is the initial interval between repeats.
+	// Every repeat shortens the interval down to stepRepeatMin.
+	stepRepeatInterval = 150 * time.Millisecond
+	stepRepeatMin      = 30 * time.Millisecond
+)
+
+// Changed reports whether Value has changed by user interaction since
+// the last call to Changed.
+func (s *Stepper) Changed() bool {
+	changed := s.changed
+	s.changed = false
+	return changed
+}
+
+// Layout updates the value from the buttons and the editor, then lays
+// out w. Text that doesn't parse as a number is reverted when the
+// editor is submitted or loses the focus.
+func (s *Stepper) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
+	s.Editor.SingleLine = true
+	s.Editor.Submit = true
+	if s.Editor.InputHint == key.HintAny {
+		s.Editor.InputHint = key.HintNumeric
+	}
+	if s.Editor.Filter == "" {
+		s.Editor.Filter = "-+.0123456789eE"
+	}
+	s.update(gtx)
+	return w(gtx)
+}
+
+func (s *Stepper) update(gtx layout.Context) {
+	s.repeat(gtx, &s.Increment, &s.inc, 1)
+	s.repeat(gtx, &s.Decrement, &s.dec, -1)
+	commit := false
+	for _, e := range s.Editor.Events() {
+		switch e.(type) {
+		case ChangeEvent:
+			v, err := strconv.ParseFloat(s.Editor.Text(), 32)
+			if err == nil && float32(v) == s.clamp(float32(v)) {
+				s.shown = float32(v)
+				s.setValue(float32(v))
+			}
+		case SubmitEvent:
+			commit = true
+		}
+	}
+	focused := s.Editor.Focused()
+	if s.focused && !focused {
+		commit = true
+	}
+	s.focused = focused
+	if v := s.clamp(s.Value); v != s.Value {
+		s.Value = v
+	}
+	if commit || !s.valid || s.shown != s.Value && !focused {
+		s.valid = true
+		s.shown = s.Value
+		s.Editor.SetText(strconv.FormatFloat(float64(s.Value), 'f', -1, 32))
+	}
+}
+
+// repeat steps the value by the clicks of b, and by a held press of
+// b after a delay, at an increasing rate.
+func (s *Stepper) repeat(gtx layout.Context, b *Clickable, r *stepRepeat, dir float32) {
+	pressed := b.Pressed()
+	switch {
+	case pressed && !r.active:
+		r.active = true
+		r.count = 0
+		r.next = gtx.Now.Add(stepRepeatDelay)
+		s.step(dir)
+	case pressed && !gtx.Now.Before(r.next):
+		r.count++
+		s.step(dir)
+		d := time.Duration(float64(stepRepeatInterval) * math.Pow(.85, float64(r.count-1)))
+		if d < stepRepeatMin {
+			d = stepRepeatMin
+		}
+		r.next = gtx.Now.Add(d)
+	}
+	for b.Clicked() {
+		// Pointer presses step the value when pressed, other
+		// clicks such as the keyboard step it here.
+		if !r.active {
+			s.step(dir)
+		}
+	}
+	if !pressed {
+		r.active = false
+	}
+	if r.active {
+		op.InvalidateOp{At: r.next}.Add(gtx.Ops)
+	}
+}
+
+func (s *Stepper) step(dir float32) {
+	step := s.Step
+	if step == 0 {
+		step = 1
+	}
+	v := s.Value + dir*step
+	// Snap to the steps from the minimum, avoiding accumulated
+	// rounding errors.
+	if step > 0 {
+		n := math.Round(float64((v - s.Min) / step))
+		v = s.Min + float32(n)*step
+	}
+	if s.setValue(s.clamp(v)) {
+		s.shown = s.Value
+		s.Editor.SetText(strconv.FormatFloat(float64(s.Value), 'f', -1, 32))
+	}
+}
+
+func (s *Stepper) setValue(v float32) bool {
+	if v == s.Value {
+		return false
+	}
+	s.Value = v
+	s.changed = true
+	return true
+}
+
+func (s *Stepper) clamp(v float32) float32 {
+	if s.Max <= s.Min {
+		return v
+	}
+	if v < s.Min {
+		return s.Min
+	}
+	if v > s.Max {
+		return s.Max
+	}
+	return v
+}
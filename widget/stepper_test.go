@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/font/gofont"
+	"gioui.org/io/event"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/text"
+	"gioui.org/unit"
+)
+
+func TestStepper(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		s   = Stepper{Max: 10, Step: .5}
+	)
+	cache := text.NewCache(gofont.Collection())
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Now:         time.Unix(0, 0),
+		Constraints: layout.Exact(image.Pt(100, 20)),
+	}
+	w := func(gtx layout.Context) layout.Dimensions {
+		gtx.Constraints = layout.Exact(image.Pt(20, 20))
+		s.Increment.Layout(gtx)
+		stack := op.Save(gtx.Ops)
+		op.Offset(f32.Pt(20, 0)).Add(gtx.Ops)
+		s.Decrement.Layout(gtx)
+		op.Offset(f32.Pt(20, 0)).Add(gtx.Ops)
+		gtx.Constraints = layout.Exact(image.Pt(60, 20))
+		s.Editor.Layout(gtx, cache, text.Font{}, unit.Px(10))
+		stack.Load()
+		return layout.Dimensions{Size: image.Pt(100, 20)}
+	}
+	frame := func(e ...event.Event) {
+		r.Frame(&ops)
+		r.Queue(e...)
+		ops.Reset()
+		s.Layout(gtx, w)
+	}
+	mouse := func(typ pointer.Type, x float32) pointer.Event {
+		return pointer.Event{
+			Type:     typ,
+			Source:   pointer.Mouse,
+			Buttons:  pointer.ButtonPrimary,
+			Position: f32.Pt(x, 10),
+		}
+	}
+	assertValue := func(name string, want float32) {
+		t.Helper()
+		if s.Value != want {
+			t.Errorf("%s: got value %v, expected %v", name, s.Value, want)
+		}
+	}
+	frame()
+	if got := s.Editor.Text(); got != "0" {
+		t.Errorf("got text %q, expected \"0\"", got)
+	}
+	frame(mouse(pointer.Press, 10))
+	frame()
+	assertValue("press", .5)
+	if !s.Changed() {
+		t.Error("press didn't change the value")
+	}
+	// Holding the button repeats after a delay.
+	gtx.Now = gtx.Now.Add(stepRepeatDelay)
+	frame()
+	assertValue("hold", 1)
+	gtx.Now = gtx.Now.Add(stepRepeatInterval)
+	frame()
+	assertValue("repeat", 1.5)
+	// The release doesn't step again.
+	frame(mouse(pointer.Release, 10))
+	frame()
+	assertValue("release", 1.5)
+	if got := s.Editor.Text(); got != "1.5" {
+		t.Errorf("got text %q, expected \"1.5\"", got)
+	}
+	s.Decrement.Click()
+	frame()
+	assertValue("click", 1)
+	// Typed values apply while they are within bounds.
+	s.Editor.Focus()
+	frame()
+	frame(key.Event{Name: key.NameEnd, State: key.Press},
+		key.Event{Name: key.NameDeleteBackward, State: key.Press},
+		key.EditEvent{Text: "2"},
+	)
+	frame()
+	assertValue("typed", 2)
+	frame(key.EditEvent{Text: "5"})
+	frame()
+	assertValue("typed beyond Max", 2)
+	frame(key.Event{Name: key.NameReturn, State: key.Press})
+	frame()
+	if got := s.Editor.Text(); got != "2" {
+		t.Errorf("got text %q after submit, expected \"2\"", got)
+	}
+}
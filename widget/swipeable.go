@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"math"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/gesture"
+	"gioui.org/internal/fling"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/op/clip"
+	"gioui.org/unit"
+)
+
+// SwipeState is the resting state of a Swipeable.
+type SwipeState uint8
+
+const (
+	// SwipeClosed is the state of a row at its normal position.
+	SwipeClosed SwipeState = iota
+	// SwipeOpen is the state of a row moved aside to reveal the
+	// actions behind it.
+	SwipeOpen
+	// SwipeDismissed is the state of a row swiped away.
+	SwipeDismissed
+)
+
+// Swipeable holds the state of a row that can be swiped horizontally
+// to reveal actions behind it or to dismiss it.
+type Swipeable struct {
+	// Reveal is the distance an open row is moved aside. Zero
+	// disables opening.
+	Reveal unit.Value
+	// Dismissible enables dismissing the row by swiping it past
+	// half its width.
+	Dismissible bool
+
+	drag      gesture.Drag
+	estimator fling.Extrapolation
+	// start is the drag position where the offset is zero.
+	start float32
+	// offset is the horizontal displacement of the row.
+	offset float32
+	// side is the direction of an open or dismissed row, 1 to
+	// the right and -1 to the left.
+	side float32
+	// revealPx is Reveal in pixels.
+	revealPx float32
+	state    SwipeState
+	changed  bool
+	// moving is set while the row animates to its resting offset.
+	moving   bool
+	animTime time.Time
+}
+
+const (
+	// swipeProjection is the duration the release velocity is
+	// extrapolated to choose the resting state.
+	swipeProjection = 150 * time.Millisecond
+	// swipeDecay is the rate of exponential decay of the distance
+	// to the resting position, per second.
+	swipeDecay = 20
+)
+
+// State returns the resting state of the row.
+func (s *Swipeable) State() SwipeState {
+	return s.state
+}
+
+// Side returns the direction of an open or dismissed row: 1 if the
+// row moved to the right, revealing the start of its background,
+// -1 otherwise.
+func (s *Swipeable) Side() float32 {
+	return s.side
+}
+
+// Changed reports whether the state changed by user interaction
+// since the last call to Changed.
+func (s *Swipeable) Changed() bool {
+	changed := s.changed
+	s.changed = false
+	return changed
+}
+
+// Close animates the row back to its normal position.
+func (s *Swipeable) Close() {
+	s.state = SwipeClosed
+}
+
+// Dragging reports whether the row is being dragged.
+func (s *Swipeable) Dragging() bool {
+	return s.drag.Dragging()
+}
+
+// Layout lays out content, displaced by the swipe, over background
+// which is laid out with the exact size of the content. Only
+// horizontal movement is claimed, leaving vertical drags to an
+// enclosing list.
+func (s *Swipeable) Layout(gtx layout.Context, background, content layout.Widget) layout.Dimensions {
+	macro := op.Record(gtx.Ops)
+	dims := content(gtx)
+	call := macro.Stop()
+	width := float32(dims.Size.X)
+	s.update(gtx, width)
+
+	defer op.Save(gtx.Ops).Load()
+	clip.Rect{Max: dims.Size}.Add(gtx.Ops)
+	if s.offset != 0 {
+		stack := op.Save(gtx.Ops)
+		gtx := gtx
+		gtx.Constraints = layout.Exact(dims.Size)
+		background(gtx)
+		stack.Load()
+	}
+	off := int(math.Round(float64(s.offset)))
+	op.Offset(f32.Pt(float32(off), 0)).Add(gtx.Ops)
+	pointer.Rect(image.Rectangle{Max: dims.Size}).Add(gtx.Ops)
+	s.drag.Add(gtx.Ops)
+	call.Add(gtx.Ops)
+	return dims
+}
+
+func (s *Swipeable) update(gtx layout.Context, width float32) {
+	s.revealPx = float32(gtx.Px(s.Reveal))
+	for _, e := range s.drag.Events(gtx.Metric, gtx, gesture.Horizontal) {
+		switch e.Type {
+		case pointer.Press:
+			s.start = e.Position.X - s.offset
+			s.estimator = fling.Extrapolation{}
+			s.estimator.Sample(e.Time, s.offset)
+		case pointer.Drag:
+			s.offset = s.clamp(e.Position.X-s.start, width)
+			s.estimator.Sample(e.Time, s.offset)
+		case pointer.Release:
+			v := s.estimator.Estimate().Velocity
+			s.snap(s.offset+v*float32(swipeProjection.Seconds()), width)
+		}
+	}
+	if s.Dragging() {
+		s.moving = false
+		return
+	}
+	target := s.target(width)
+	if s.offset == target {
+		s.moving = false
+		return
+	}
+	if !s.moving {
+		s.moving = true
+		s.animTime = gtx.Now
+	}
+	dt := gtx.Now.Sub(s.animTime).Seconds()
+	s.animTime = gtx.Now
+	s.offset = target + (s.offset-target)*float32(math.Exp(-swipeDecay*dt))
+	if d := s.offset - target; -.5 < d && d < .5 {
+		s.offset = target
+	}
+	op.InvalidateOp{}.Add(gtx.Ops)
+}
+
+// clamp limits an offset to the extent of the row movement.
+func (s *Swipeable) clamp(off, width float32) float32 {
+	var max float32
+	if s.Dismissible {
+		max = width
+	} else {
+		max = s.reveal(width)
+	}
+	if off > max {
+		off = max
+	} else if off < -max {
+		off = -max
+	}
+	return off
+}
+
+// snap chooses the resting state from the projected offset.
+func (s *Swipeable) snap(off, width float32) {
+	state := SwipeClosed
+	dist := off
+	if dist < 0 {
+		dist = -dist
+	}
+	switch {
+	case s.Dismissible && dist > width*.5:
+		state = SwipeDismissed
+	case s.revealPx > 0 && dist > s.reveal(width)*.5:
+		state = SwipeOpen
+	}
+	if state != SwipeClosed {
+		s.side = 1
+		if off < 0 {
+			s.side = -1
+		}
+	}
+	if state != s.state {
+		s.state = state
+		s.changed = true
+	}
+}
+
+// target returns the resting offset of the current state.
+func (s *Swipeable) target(width float32) float32 {
+	switch s.state {
+	case SwipeOpen:
+		return s.side * s.reveal(width)
+	case SwipeDismissed:
+		return s.side * width
+	default:
+		return 0
+	}
+}
+
+func (s *Swipeable) reveal(width float32) float32 {
+	r := s.revealPx
+	if r > width {
+		r = width
+	}
+	return r
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/event"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/unit"
+)
+
+func TestSwipeable(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Now:         time.Unix(0, 0),
+		Constraints: layout.Exact(image.Pt(100, 20)),
+	}
+	w := func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: gtx.Constraints.Min}
+	}
+	touch := func(typ pointer.Type, x, y float32, t time.Duration) pointer.Event {
+		return pointer.Event{
+			Type:     typ,
+			Source:   pointer.Touch,
+			Position: f32.Pt(x, y),
+			Time:     t,
+		}
+	}
+	for _, tc := range []struct {
+		label  string
+		events []event.Event
+		state  SwipeState
+		offset float32
+	}{
+		{
+			label: "short swipe",
+			events: []event.Event{
+				touch(pointer.Press, 50, 10, 0),
+				touch(pointer.Drag, 40, 10, time.Second),
+				touch(pointer.Release, 40, 10, 2*time.Second),
+			},
+			state: SwipeClosed,
+		},
+		{
+			label: "open",
+			events: []event.Event{
+				touch(pointer.Press, 50, 10, 0),
+				touch(pointer.Drag, 20, 10, time.Second),
+				touch(pointer.Release, 20, 10, 2*time.Second),
+			},
+			state:  SwipeOpen,
+			offset: -40,
+		},
+		{
+			label: "dismiss",
+			events: []event.Event{
+				touch(pointer.Press, 10, 10, 0),
+				touch(pointer.Drag, 70, 10, time.Second),
+				touch(pointer.Release, 70, 10, 2*time.Second),
+			},
+			state:  SwipeDismissed,
+			offset: 100,
+		},
+		{
+			label: "vertical drag",
+			events: []event.Event{
+				touch(pointer.Press, 50, 10, 0),
+				touch(pointer.Drag, 50, 80, time.Second),
+				touch(pointer.Release, 50, 80, 2*time.Second),
+			},
+			state: SwipeClosed,
+		},
+	} {
+		t.Run(tc.label, func(t *testing.T) {
+			s := Swipeable{Reveal: unit.Px(40), Dismissible: true}
+			frame := func() {
+				ops.Reset()
+				s.Layout(gtx, w, w)
+				r.Frame(&ops)
+			}
+			frame()
+			for _, e := range tc.events {
+				r.Queue(e)
+				frame()
+			}
+			if got := s.State(); got != tc.state {
+				t.Errorf("got state %v, expected %v", got, tc.state)
+			}
+			if changed := s.Changed(); changed != (tc.state != SwipeClosed) {
+				t.Errorf("got changed %v", changed)
+			}
+			frame()
+			gtx.Now = gtx.Now.Add(time.Second)
+			frame()
+			if s.offset != tc.offset {
+				t.Errorf("got offset %v after the animation, expected %v", s.offset, tc.offset)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"time"
+
+	"gioui.org/layout"
+)
+
+// Tabs holds the state of a row of tabs, of which one is selected.
+type Tabs struct {
+	// Selected is the index of the selected tab.
+	Selected int
+	// List is the state of the scrollable row of tabs.
+	List layout.List
+
+	changed    bool
+	changeTime time.Time
+	prev       int
+
+	clicks []*Clickable
+}
+
+// Changed reports whether Selected has changed by user interaction
+// since the last call to Changed.
+func (t *Tabs) Changed() bool {
+	changed := t.changed
+	t.changed = false
+	return changed
+}
+
+// ChangeTime returns the time of the most recent change of Selected
+// by user interaction. It is useful for animating the transition
+// between tabs.
+func (t *Tabs) ChangeTime() time.Time {
+	return t.changeTime
+}
+
+// Previous returns the tab selected before the most recent change by
+// user interaction.
+func (t *Tabs) Previous() int {
+	return t.prev
+}
+
+// History is the past pointer presses of tab i useful for drawing
+// markers.
+func (t *Tabs) History(i int) []Press {
+	if i < 0 || i >= len(t.clicks) {
+		return nil
+	}
+	return t.clicks[i].History()
+}
+
+// Hovered reports whether a pointer is over tab i.
+func (t *Tabs) Hovered(i int) bool {
+	return i >= 0 && i < len(t.clicks) && t.clicks[i].Hovered()
+}
+
+// Layout adds the event handler for tab i.
+func (t *Tabs) Layout(gtx layout.Context, i int) layout.Dimensions {
+	for len(t.clicks) <= i {
+		t.clicks = append(t.clicks, new(Clickable))
+	}
+	clk := t.clicks[i]
+	// Only the selected tab is a Tab stop.
+	clk.Focusable = i == t.Selected
+	dims := clk.Layout(gtx)
+	for clk.Clicked() {
+		if i != t.Selected {
+			t.prev = t.Selected
+			t.Selected = i
+			t.changed = true
+			t.changeTime = gtx.Now
+		}
+	}
+	return dims
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestTabsSelect(t *testing.T) {
+	var (
+		ops  op.Ops
+		r    router.Router
+		tabs Tabs
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	frame := func() {
+		ops.Reset()
+		for i := 0; i < 2; i++ {
+			stack := op.Save(gtx.Ops)
+			op.Offset(f32.Pt(float32(i*100), 0)).Add(gtx.Ops)
+			tabs.Layout(gtx, i)
+			stack.Load()
+		}
+		r.Frame(&ops)
+	}
+	frame()
+	r.Queue(
+		pointer.Event{
+			Source:   pointer.Mouse,
+			Buttons:  pointer.ButtonPrimary,
+			Type:     pointer.Press,
+			Position: f32.Pt(150, 50),
+		},
+		pointer.Event{
+			Source:   pointer.Mouse,
+			Type:     pointer.Release,
+			Position: f32.Pt(150, 50),
+		},
+	)
+	frame()
+	if !tabs.Changed() {
+		t.Error("tabs not changed after click")
+	}
+	if tabs.Selected != 1 || tabs.Previous() != 0 {
+		t.Errorf("got selected %d, previous %d; expected 1, 0", tabs.Selected, tabs.Previous())
+	}
+	if tabs.Changed() {
+		t.Error("tabs changed twice")
+	}
+}
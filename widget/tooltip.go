@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/gesture"
+	"gioui.org/io/pointer"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// Tooltip tracks the pointer and focus state of a widget to decide
+// when to show its tooltip.
+type Tooltip struct {
+	// HoverDelay is the duration the pointer must stay over the
+	// widget before the tooltip is shown. If zero, a default of
+	// 500ms is used.
+	HoverDelay time.Duration
+
+	hover gesture.Hover
+	// pressed is set when the widget is pressed and hides the
+	// tooltip until the pointer leaves.
+	pressed    bool
+	hoverStart time.Time
+	focused    bool
+	visible    bool
+}
+
+const defaultHoverDelay = 500 * time.Millisecond
+
+// SetFocused sets whether the widget has keyboard focus. A focused
+// widget shows its tooltip immediately. Call SetFocused before
+// Layout, typically with the result of Clickable.Focused.
+func (t *Tooltip) SetFocused(focused bool) {
+	t.focused = focused
+}
+
+// Visible reports whether the tooltip should be shown, as determined
+// by the most recent call to Layout.
+func (t *Tooltip) Visible() bool {
+	return t.visible
+}
+
+// Layout lays out w and tracks the pointer over it. Pointer events
+// are passed through to the handlers of w.
+func (t *Tooltip) Layout(gtx layout.Context, w layout.Widget) layout.Dimensions {
+	t.update(gtx)
+	dims := w(gtx)
+	defer op.Save(gtx.Ops).Load()
+	pointer.PassOp{Pass: true}.Add(gtx.Ops)
+	pointer.Rect(image.Rectangle{Max: dims.Size}).Add(gtx.Ops)
+	t.hover.Add(gtx.Ops)
+	pointer.InputOp{Tag: t, Types: pointer.Press}.Add(gtx.Ops)
+	if t.hover.Hovered() && !t.pressed && !t.visible {
+		op.InvalidateOp{At: t.hoverStart.Add(t.hoverDelay())}.Add(gtx.Ops)
+	}
+	return dims
+}
+
+func (t *Tooltip) hoverDelay() time.Duration {
+	if d := t.HoverDelay; d > 0 {
+		return d
+	}
+	return defaultHoverDelay
+}
+
+func (t *Tooltip) update(gtx layout.Context) {
+	t.hover.Update(gtx)
+	if t.hover.Entered() {
+		t.hoverStart = gtx.Now
+	}
+	if !t.hover.Hovered() {
+		t.pressed = false
+	}
+	for _, e := range gtx.Events(t) {
+		if e, ok := e.(pointer.Event); ok && e.Type == pointer.Press {
+			t.pressed = true
+		}
+	}
+	hover := t.hover.Hovered() && !t.pressed && !gtx.Now.Before(t.hoverStart.Add(t.hoverDelay()))
+	t.visible = hover || t.focused
+}
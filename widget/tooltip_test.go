@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestTooltipHoverDelay(t *testing.T) {
+	var (
+		ops op.Ops
+		r   router.Router
+		tip Tooltip
+	)
+	gtx := layout.Context{
+		Ops:         &ops,
+		Queue:       &r,
+		Now:         time.Unix(0, 0),
+		Constraints: layout.Exact(image.Pt(100, 100)),
+	}
+	w := func(gtx layout.Context) layout.Dimensions {
+		return layout.Dimensions{Size: gtx.Constraints.Min}
+	}
+	frame := func() {
+		ops.Reset()
+		tip.Layout(gtx, w)
+		r.Frame(&ops)
+	}
+	frame()
+	r.Queue(pointer.Event{
+		Type:     pointer.Move,
+		Source:   pointer.Mouse,
+		Position: f32.Pt(50, 50),
+	})
+	frame()
+	if tip.Visible() {
+		t.Fatal("tooltip visible before the hover delay")
+	}
+	gtx.Now = gtx.Now.Add(defaultHoverDelay)
+	frame()
+	if !tip.Visible() {
+		t.Fatal("tooltip not visible after the hover delay")
+	}
+	r.Queue(pointer.Event{
+		Type:     pointer.Move,
+		Source:   pointer.Mouse,
+		Position: f32.Pt(150, 50),
+	})
+	frame()
+	if tip.Visible() {
+		t.Error("tooltip visible after the pointer left")
+	}
+	tip.SetFocused(true)
+	frame()
+	if !tip.Visible() {
+		t.Error("tooltip not visible while focused")
+	}
+}
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: Unlicense OR MIT
+
+package widget
+
+import (
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// undoHistory records the changes to the contents of an Editor.
+type undoHistory struct {
+	undo, redo []undoGroup
+	// typing is set if the most recent change was the typing of
+	// a single rune.
+	typing bool
+	// merge is set if a typed rune may be merged into the most
+	// recent group.
+	merge bool
+	// batch counts the nested changes that form a single group.
+	batch int
+	// batched is set if the current batch has started a group.
+	batched bool
+}
+
+// undoGroup is a list of changes undone and redone as a unit.
+type undoGroup struct {
+	edits []undoEdit
+}
+
+// undoEdit replaces the text deleted at ofs with the text inserted.
+type undoEdit struct {
+	ofs      int
+	deleted  string
+	inserted string
+}
+
+const defaultUndoLimit = 100
+
+// Undo reverts the most recent change to the contents of the editor,
+// if any.
+func (e *Editor) Undo() {
+	h := &e.history
+	n := len(h.undo)
+	if n == 0 {
+		return
+	}
+	g := h.undo[n-1]
+	h.undo = h.undo[:n-1]
+	h.redo = append(h.redo, g)
+	h.typing = false
+	for i := len(g.edits) - 1; i >= 0; i-- {
+		ed := g.edits[i]
+		e.rr.deleteRunes(ed.ofs, utf8.RuneCountInString(ed.inserted))
+		e.rr.prepend(ed.ofs, ed.deleted)
+		// Select the restored text.
+		e.caret.start.ofs = ed.ofs + len(ed.deleted)
+		e.caret.end.ofs = ed.ofs
+	}
+	e.caret.start.xoff = 0
+	e.caret.scroll = true
+	e.invalidate()
+}
+
+// Redo reapplies the most recent change reverted by Undo, if any.
+// Redo history is cleared by further changes.
+func (e *Editor) Redo() {
+	h := &e.history
+	n := len(h.redo)
+	if n == 0 {
+		return
+	}
+	g := h.redo[n-1]
+	h.redo = h.redo[:n-1]
+	h.undo = append(h.undo, g)
+	h.typing = false
+	for _, ed := range g.edits {
+		e.rr.deleteRunes(ed.ofs, utf8.RuneCountInString(ed.deleted))
+		e.rr.prepend(ed.ofs, ed.inserted)
+		e.caret.start.ofs = ed.ofs + len(ed.inserted)
+		e.caret.end.ofs = e.caret.start.ofs
+	}
+	e.caret.start.xoff = 0
+	e.caret.scroll = true
+	e.invalidate()
+}
+
+// beginBatch starts recording the following changes as a single
+// group, until the matching call to endBatch.
+func (e *Editor) beginBatch() {
+	if e.history.batch == 0 {
+		e.history.batched = false
+	}
+	e.history.batch++
+}
+
+func (e *Editor) endBatch() {
+	e.history.batch--
+}
+
+// typeText inserts typed text at the caret, merging single runes
+// into the previous group of typed runes.
+func (e *Editor) typeText(s string) {
+	single := utf8.RuneCountInString(s) == 1
+	e.history.merge = single && e.history.typing
+	e.append(s)
+	e.history.merge = false
+	e.history.typing = single
+}
+
+// deleteRunes is like editBuffer.deleteRunes but records the deletion.
+func (e *Editor) deleteRunes(caret, runes int) int {
+	start, end := caret, caret
+	for ; runes < 0 && start > 0; runes++ {
+		_, s := e.rr.runeBefore(start)
+		start -= s
+	}
+	for ; runes > 0 && end < e.rr.len(); runes-- {
+		_, s := e.rr.runeAt(end)
+		end += s
+	}
+	if start == end {
+		return caret
+	}
+	buf := make([]byte, end-start)
+	e.rr.Seek(int64(start), io.SeekStart)
+	e.rr.Read(buf)
+	e.record(undoEdit{ofs: start, deleted: string(buf)})
+	e.rr.moveGap(end, 0)
+	e.rr.gapstart -= end - start
+	e.rr.changed = true
+	if caret > start {
+		caret = start
+	}
+	return caret
+}
+
+// prependText is like editBuffer.prepend but records the insertion.
+func (e *Editor) prependText(caret int, s string) {
+	if s == "" {
+		return
+	}
+	e.record(undoEdit{ofs: caret, inserted: s})
+	e.rr.prepend(caret, s)
+}
+
+// record adds ed to the undo history and clears the redo history.
+func (e *Editor) record(ed undoEdit) {
+	h := &e.history
+	h.redo = h.redo[:0]
+	if n := len(h.undo); n > 0 {
+		g := &h.undo[n-1]
+		switch {
+		case h.batch > 0 && h.batched:
+			g.edits = append(g.edits, ed)
+			return
+		case h.merge && ed.deleted == "":
+			last := &g.edits[len(g.edits)-1]
+			if last.deleted == "" && last.ofs+len(last.inserted) == ed.ofs && !wordBreak(last.inserted, ed.inserted) {
+				last.inserted += ed.inserted
+				return
+			}
+		}
+	}
+	h.batched = h.batch > 0
+	h.undo = append(h.undo, undoGroup{edits: []undoEdit{ed}})
+	limit := e.UndoLimit
+	if limit <= 0 {
+		limit = defaultUndoLimit
+	}
+	if n := len(h.undo) - limit; n > 0 {
+		h.undo = h.undo[:copy(h.undo, h.undo[n:])]
+	}
+}
+
+// clearHistory discards the undo and redo history.
+func (e *Editor) clearHistory() {
+	e.history.undo = e.history.undo[:0]
+	e.history.redo = e.history.redo[:0]
+	e.history.typing = false
+}
+
+// wordBreak reports whether typing next after prev starts a new word.
+func wordBreak(prev, next string) bool {
+	p, _ := utf8.DecodeLastRuneInString(prev)
+	n, _ := utf8.DecodeRuneInString(next)
+	return unicode.IsSpace(n) && !unicode.IsSpace(p)
+}